@@ -22,11 +22,18 @@ func main() {
 
 	log.Info("", slog.Any("cfg", cfg))
 
-	application := app.New(log, cfg.GRPC.Port, cfg.UserInfoPort, cfg.StorageURL, cfg.GRPC.RetryCount, cfg.GRPC.Timeout)
+	application := app.New(log, cfg)
 
 	go application.GRPCApp.MustRun()
+	if application.ConsumerApp != nil {
+		go application.ConsumerApp.MustRun()
+	}
+	go application.RelayApp.MustRun()
+	if application.RedeliveryApp != nil {
+		go application.RedeliveryApp.MustRun()
+	}
 
-	stop := make(chan os.Signal)
+	stop := make(chan os.Signal, 1)
 
 	signal.Notify(stop, syscall.SIGTERM, syscall.SIGINT)
 
@@ -35,6 +42,13 @@ func main() {
 	log.Info("received signal", slog.Any("signal", sign))
 
 	application.GRPCApp.Stop()
+	if application.ConsumerApp != nil {
+		application.ConsumerApp.Stop()
+	}
+	application.RelayApp.Stop()
+	if application.RedeliveryApp != nil {
+		application.RedeliveryApp.Stop()
+	}
 }
 
 // setUpLogger returns set logger according to current environment
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"github.com/IlianBuh/Follow_Service/internal/config"
+	"github.com/IlianBuh/Follow_Service/internal/lib/logger/sl"
+	"github.com/IlianBuh/Follow_Service/internal/migrator"
+	"log/slog"
+	"os"
+)
+
+const (
+	cmdUp      = "up"
+	cmdDown    = "down"
+	cmdVersion = "version"
+)
+
+// migrate applies or reverts migrations of the storage configured in the config file
+//
+// usage: migrate [-config path] [-steps n] up|down|version
+func main() {
+	steps := flag.Int("steps", 1, "number of migrations to revert by down command")
+
+	cfg := config.MustLoad()
+
+	log := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelInfo}))
+
+	m, err := migrator.New(log, cfg.StorageDriver, cfg.StorageURL)
+	if err != nil {
+		log.Error("failed to create migrator", sl.Err(err))
+		os.Exit(1)
+	}
+	defer m.Close()
+
+	ctx := context.Background()
+	switch cmd := flag.Arg(0); cmd {
+	case cmdUp:
+		var applied int
+		applied, err = m.Up(ctx)
+		log.Info("migrations applied", slog.Int("count", applied))
+	case cmdDown:
+		var reverted int
+		reverted, err = m.Down(ctx, *steps)
+		log.Info("migrations reverted", slog.Int("count", reverted))
+	case cmdVersion:
+		var version int
+		version, err = m.Version(ctx)
+		log.Info("current schema version", slog.Int("version", version))
+	default:
+		err = fmt.Errorf("unknown command %q, expected one of: up, down, version", cmd)
+	}
+	if err != nil {
+		log.Error("failed to migrate", sl.Err(err))
+		m.Close()
+		os.Exit(1)
+	}
+}
@@ -1,41 +1,200 @@
 package app
 
 import (
+	"context"
 	"fmt"
+	consumerapp "github.com/IlianBuh/Follow_Service/internal/app/consumer"
 	grpcapp "github.com/IlianBuh/Follow_Service/internal/app/grpc"
+	redeliveryapp "github.com/IlianBuh/Follow_Service/internal/app/redelivery"
+	relayapp "github.com/IlianBuh/Follow_Service/internal/app/relay"
+	"github.com/IlianBuh/Follow_Service/internal/clients/events/file"
 	grpclient "github.com/IlianBuh/Follow_Service/internal/clients/grpc"
+	"github.com/IlianBuh/Follow_Service/internal/clients/publishers/logging"
+	"github.com/IlianBuh/Follow_Service/internal/clients/publishers/webhook"
+	"github.com/IlianBuh/Follow_Service/internal/config"
+	"github.com/IlianBuh/Follow_Service/internal/domain/models"
+	"github.com/IlianBuh/Follow_Service/internal/lib/validator"
+	"github.com/IlianBuh/Follow_Service/internal/migrator"
 	"github.com/IlianBuh/Follow_Service/internal/service/follow"
+	"github.com/IlianBuh/Follow_Service/internal/service/graph"
+	"github.com/IlianBuh/Follow_Service/internal/service/idempotency"
+	"github.com/IlianBuh/Follow_Service/internal/service/ratelimit"
+	"github.com/IlianBuh/Follow_Service/internal/service/suggest"
+	"github.com/IlianBuh/Follow_Service/internal/service/watch"
+	"github.com/IlianBuh/Follow_Service/internal/storage/memory"
+	"github.com/IlianBuh/Follow_Service/internal/storage/postgres"
 	"github.com/IlianBuh/Follow_Service/internal/storage/sqlite"
 	"log/slog"
 	"time"
 )
 
+const (
+	driverSQLite   = "sqlite"
+	driverPostgres = "postgres"
+
+	eventSourceFile = "file"
+
+	publisherLog     = "log"
+	publisherWebhook = "webhook"
+)
+
 type App struct {
 	GRPCApp *grpcapp.App
+	// ConsumerApp is nil if consuming of user events is disabled
+	ConsumerApp *consumerapp.App
+	RelayApp    *relayapp.App
+	// RedeliveryApp is nil if the publisher does not retry failed deliveries
+	RedeliveryApp *redeliveryapp.App
+}
+
+type Storage interface {
+	follow.Follower
+	follow.Unfollower
+	follow.BulkFollower
+	follow.FollowingsProvider
+	follow.FollowingsStreamer
+	follow.CommonFollowersProvider
+	follow.CountersProvider
+	follow.RelationshipsProvider
+	follow.Blocker
+	follow.PrivacyManager
+	follow.RequestsManager
+	follow.Muter
+	follow.GraphEraser
+	relayapp.OutboxProvider
+	webhook.DeliveriesStorage
+	follow.MutualsProvider
+	suggest.SuggestionsProvider
+	graph.NeighboursProvider
+	watch.EventsProvider
+	idempotency.KeysStorage
 }
 
 func New(
 	log *slog.Logger,
-	port int,
-	usrInfoPort int,
-	storagePath string,
-	retryCount int,
-	timeout time.Duration,
+	cfg *config.Config,
 ) *App {
-	st, err := sqlite.New(storagePath)
+	if cfg.Migrations.OnStart {
+		mustMigrate(log, cfg.StorageDriver, cfg.StorageURL)
+	}
+
+	st, err := newStorage(cfg.StorageDriver, cfg.StorageURL)
+	if err != nil {
+		panic(err)
+	}
+
+	cl, err := grpclient.New(
+		log,
+		fmt.Sprintf(":%d", cfg.UserInfoPort),
+		cfg.GRPC.RetryCount,
+		cfg.GRPC.Timeout,
+	)
 	if err != nil {
 		panic(err)
 	}
+	vl := validator.New(validator.Limits{MaxBatchSize: cfg.Limits.MaxBatchSize})
+	rl := ratelimit.New(
+		log,
+		memory.New(),
+		models.RateLimit{Count: cfg.Limits.FollowsPerMinute, Window: time.Minute},
+		models.RateLimit{Count: cfg.Limits.FollowsPerDay, Window: 24 * time.Hour},
+	)
+	fl := follow.New(log, st, st, st, st, st, st, st, st, st, st, st, st, st, st, cl, vl, rl, cfg.Limits.MaxFollowees)
+
+	sg := suggest.New(log, st, vl)
+	gr := graph.New(log, st, vl)
+	wt := watch.New(log, st, cfg.Watch.PollInterval, cfg.Watch.BatchSize, vl)
+	id := idempotency.New(log, st, cfg.Idempotency.TTL)
+
+	application := grpcapp.New(log, cfg.GRPC.Port, cfg.GRPC.AdminPort, fl, sg, gr, fl, wt, id)
+
+	var consumer *consumerapp.App
+	if cfg.Events.Source != "" {
+		src, err := newEventSource(log, cfg.Events)
+		if err != nil {
+			panic(err)
+		}
+
+		consumer = consumerapp.New(log, src, fl, cfg.Events.BaseBackoff, cfg.Events.MaxBackoff)
+	}
 
-	cl, err := grpclient.New(log, fmt.Sprintf(":%d", usrInfoPort), retryCount, timeout)
+	pub, err := newPublisher(log, cfg.Outbox, st)
 	if err != nil {
 		panic(err)
 	}
-	fl := follow.New(log, st, st, st, cl)
+	relay := relayapp.New(log, st, pub, cfg.Outbox.PollInterval, cfg.Outbox.BatchSize, cfg.Outbox.Lease, cfg.Outbox.Retention)
 
-	application := grpcapp.New(log, port, fl)
+	var redelivery *redeliveryapp.App
+	if rdl, ok := pub.(redeliveryapp.Redeliverer); ok {
+		redelivery = redeliveryapp.New(log, rdl, cfg.Outbox.PollInterval, cfg.Outbox.BatchSize)
+	}
 
 	return &App{
-		GRPCApp: application,
+		GRPCApp:       application,
+		ConsumerApp:   consumer,
+		RelayApp:      relay,
+		RedeliveryApp: redelivery,
 	}
 }
+
+// mustMigrate applies all pending migrations to the storage. Panics if error occurred
+func mustMigrate(log *slog.Logger, driver, url string) {
+	m, err := migrator.New(log, driver, url)
+	if err != nil {
+		panic(err)
+	}
+	defer m.Close()
+
+	if _, err = m.Up(context.Background()); err != nil {
+		panic(err)
+	}
+}
+
+// newStorage returns storage implementation chosen by the driver
+func newStorage(driver, url string) (Storage, error) {
+	switch driver {
+	case driverSQLite:
+		return sqlite.New(url)
+	case driverPostgres:
+		return postgres.New(url)
+	}
+
+	return nil, fmt.Errorf("unknown storage driver: %s", driver)
+}
+
+// newEventSource returns source of user events chosen by the config
+func newEventSource(log *slog.Logger, cfg config.EventsObj) (consumerapp.Source, error) {
+	switch cfg.Source {
+	case eventSourceFile:
+		return file.New(log, cfg.Path, cfg.PollInterval), nil
+	}
+
+	return nil, fmt.Errorf("unknown event source: %s", cfg.Source)
+}
+
+// newPublisher returns publisher of follow events chosen by the config
+func newPublisher(log *slog.Logger, cfg config.OutboxObj, dlvStr webhook.DeliveriesStorage) (relayapp.Publisher, error) {
+	switch cfg.Publisher {
+	case publisherLog:
+		return logging.New(log), nil
+	case publisherWebhook:
+		endpoints := make([]webhook.Endpoint, 0, len(cfg.Webhook.Endpoints))
+		for _, endpoint := range cfg.Webhook.Endpoints {
+			endpoints = append(endpoints, webhook.Endpoint{URL: endpoint.URL, Secret: endpoint.Secret})
+		}
+
+		return webhook.New(
+			log,
+			endpoints,
+			webhook.Options{
+				Timeout:     cfg.Webhook.Timeout,
+				MaxAttempts: cfg.Webhook.MaxAttempts,
+				BaseBackoff: cfg.Webhook.BaseBackoff,
+				MaxBackoff:  cfg.Webhook.MaxBackoff,
+			},
+			dlvStr,
+		), nil
+	}
+
+	return nil, fmt.Errorf("unknown publisher: %s", cfg.Publisher)
+}
@@ -0,0 +1,126 @@
+package consumerapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/IlianBuh/Follow_Service/internal/domain/models"
+	"github.com/IlianBuh/Follow_Service/internal/lib/logger/sl"
+	"github.com/IlianBuh/Follow_Service/internal/lib/validator"
+	"log/slog"
+	"time"
+)
+
+type Source interface {
+	Subscribe(ctx context.Context, handle func(context.Context, models.UserEvent) error) error
+}
+type Purger interface {
+	DeleteUserGraph(ctx context.Context, uuid int) (models.DeletedGraph, error)
+}
+
+type App struct {
+	log         *slog.Logger
+	src         Source
+	prg         Purger
+	baseBackoff time.Duration
+	maxBackoff  time.Duration
+	ctx         context.Context
+	cancel      context.CancelFunc
+	done        chan struct{}
+}
+
+// New returns new consumer application which purges graphs of users deleted
+// in the identity service. Failed purging is retried with delay growing twice
+// from 'baseBackoff' up to 'maxBackoff'
+func New(
+	log *slog.Logger,
+	src Source,
+	prg Purger,
+	baseBackoff, maxBackoff time.Duration,
+) *App {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &App{
+		log:         log,
+		src:         src,
+		prg:         prg,
+		baseBackoff: baseBackoff,
+		maxBackoff:  maxBackoff,
+		ctx:         ctx,
+		cancel:      cancel,
+		done:        make(chan struct{}),
+	}
+}
+
+// MustRun starts consuming and throw panic if error occurred
+func (a *App) MustRun() {
+	if err := a.Run(); err != nil {
+		panic("failed to run consumer application: " + err.Error())
+	}
+}
+
+// Run consumes user events until the application is stopped
+func (a *App) Run() error {
+	const op = "consumerapp.Run"
+	log := a.log.With(slog.String("op", op))
+	log.Info("starting consumer application")
+
+	defer close(a.done)
+
+	if err := a.src.Subscribe(a.ctx, a.handle); err != nil {
+		log.Error("failed to consume events", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// Stop stops consuming and waits for the event being handled
+func (a *App) Stop() {
+	a.log.Info("stopping consumer application")
+
+	a.cancel()
+	<-a.done
+}
+
+// handle purges the graph of the deleted user. Other events are ignored. Purging is retried
+// until it succeeds or the application is stopped, so failures of the storage do not leave
+// followings of deleted users. Events with invalid uuid are skipped as they never succeed
+func (a *App) handle(ctx context.Context, event models.UserEvent) error {
+	const op = "consumerapp.handle"
+	log := a.log.With(slog.String("op", op))
+
+	if event.Type != models.UserEventDeleted {
+		log.Debug("event is ignored", slog.String("type", string(event.Type)))
+		return nil
+	}
+
+	backoff := a.baseBackoff
+	for {
+		_, err := a.prg.DeleteUserGraph(ctx, event.UUID)
+		if err == nil {
+			return nil
+		}
+		if errors.Is(err, validator.ErrInvalidUUID) {
+			log.Warn("event with invalid uuid is skipped", slog.Int("uuid", event.UUID), sl.Err(err))
+			return nil
+		}
+
+		log.Warn(
+			"failed to purge graph, retrying",
+			slog.Int("uuid", event.UUID),
+			slog.Duration("backoff", backoff),
+			sl.Err(err),
+		)
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		backoff = min(2*backoff, a.maxBackoff)
+	}
+}
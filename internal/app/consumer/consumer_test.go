@@ -0,0 +1,87 @@
+package consumerapp_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	consumerapp "github.com/IlianBuh/Follow_Service/internal/app/consumer"
+	"github.com/IlianBuh/Follow_Service/internal/clients/events/memory"
+	"github.com/IlianBuh/Follow_Service/internal/domain/models"
+	"github.com/IlianBuh/Follow_Service/internal/lib/validator"
+	"github.com/stretchr/testify/require"
+	"io"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+)
+
+// flakyPurger fails first 'failures' purges and records the purged users
+type flakyPurger struct {
+	mu       sync.Mutex
+	failures int
+	purged   []int
+}
+
+func (p *flakyPurger) DeleteUserGraph(_ context.Context, uuid int) (models.DeletedGraph, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if err := validator.New(validator.Limits{}).UUIDs(uuid); err != nil {
+		return models.DeletedGraph{}, fmt.Errorf("purger: %w", err)
+	}
+	if p.failures > 0 {
+		p.failures--
+		return models.DeletedGraph{}, errors.New("storage is unavailable")
+	}
+
+	p.purged = append(p.purged, uuid)
+	return models.DeletedGraph{}, nil
+}
+
+func (p *flakyPurger) Purged() []int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return append([]int(nil), p.purged...)
+}
+
+func TestPurgeIsRetried(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	src := memory.New(log, 4)
+	prg := &flakyPurger{failures: 3}
+
+	app := consumerapp.New(log, src, prg, time.Millisecond, 5*time.Millisecond)
+	go app.MustRun()
+	t.Cleanup(app.Stop)
+
+	ctx := context.Background()
+	require.NoError(t, src.Publish(ctx, models.UserEvent{Type: "user.created", UUID: 1}))
+	require.NoError(t, src.Publish(ctx, models.UserEvent{Type: models.UserEventDeleted, UUID: 2}))
+	require.NoError(t, src.Publish(ctx, models.UserEvent{Type: models.UserEventDeleted, UUID: 3}))
+
+	require.Eventually(t, func() bool {
+		return len(prg.Purged()) == 2
+	}, time.Second, time.Millisecond)
+	require.Equal(t, []int{2, 3}, prg.Purged())
+}
+
+func TestInvalidUUIDIsSkipped(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	src := memory.New(log, 4)
+	prg := &flakyPurger{}
+
+	app := consumerapp.New(log, src, prg, time.Millisecond, 5*time.Millisecond)
+	go app.MustRun()
+	t.Cleanup(app.Stop)
+
+	ctx := context.Background()
+	require.NoError(t, src.Publish(ctx, models.UserEvent{Type: models.UserEventDeleted}))
+	require.NoError(t, src.Publish(ctx, models.UserEvent{Type: models.UserEventDeleted, UUID: -1}))
+	require.NoError(t, src.Publish(ctx, models.UserEvent{Type: models.UserEventDeleted, UUID: 4}))
+
+	require.Eventually(t, func() bool {
+		return len(prg.Purged()) == 1
+	}, time.Second, time.Millisecond)
+	require.Equal(t, []int{4}, prg.Purged())
+}
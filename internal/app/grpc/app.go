@@ -3,6 +3,7 @@ package grpcapp
 import (
 	"context"
 	"fmt"
+	"github.com/IlianBuh/Follow_Service/internal/domain/models"
 	"github.com/IlianBuh/Follow_Service/internal/lib/logger/sl"
 	grpcfllw "github.com/IlianBuh/Follow_Service/internal/transport/grpc"
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
@@ -15,21 +16,110 @@ import (
 type App struct {
 	log     *slog.Logger
 	gRPCSrv *grpc.Server
-	port    int
+	// adminSrv serves administrative API on the separate port. It is nil if admin API is disabled
+	adminSrv  *grpc.Server
+	wtch      Watcher
+	port      int
+	adminPort int
 }
 
 type Service interface {
-	Follow(ctx context.Context, src, target int) error
-	Unfollow(ctx context.Context, src, target int) error
-	ListFollowers(ctx context.Context, uuid int) ([]int, error)
-	ListFollowees(ctx context.Context, uuid int) ([]int, error)
+	Follow(ctx context.Context, src, target int) (models.FollowState, error)
+	Unfollow(ctx context.Context, src, target int, strict bool) (bool, error)
+	BulkFollow(ctx context.Context, src int, targets []int) ([]models.BulkResult, error)
+	BulkUnfollow(ctx context.Context, src int, targets []int) ([]models.BulkResult, error)
+	ListFollowers(
+		ctx context.Context,
+		uuid, pageSize int,
+		pageToken string,
+		newestFirst bool,
+	) ([]models.Following, string, error)
+	ListFollowees(
+		ctx context.Context,
+		uuid, pageSize int,
+		pageToken string,
+		newestFirst, excludeMuted bool,
+	) ([]models.Following, string, error)
+	CommonFollowers(ctx context.Context, viewer, target, sampleSize int) ([]int, int, error)
+	StreamFollowers(ctx context.Context, uuid, chunkSize int, send func([]int) error) error
+	StreamFollowees(ctx context.Context, uuid, chunkSize int, send func([]int) error) error
+	CountFollowers(ctx context.Context, uuid int) (int, error)
+	CountFollowees(ctx context.Context, uuid int) (int, error)
+	Relationships(ctx context.Context, viewer int, targets []int) ([]models.Relationship, error)
+	Block(ctx context.Context, src, target int) error
+	Unblock(ctx context.Context, src, target int) error
+	ListBlocked(ctx context.Context, uuid, pageSize int, pageToken string) ([]int, string, error)
+	SetPrivacy(ctx context.Context, uuid int, private bool) error
+	IsPrivate(ctx context.Context, uuid int) (bool, error)
+	ListFollowRequests(ctx context.Context, uuid, pageSize int, pageToken string) ([]models.Following, string, error)
+	ApproveFollowRequest(ctx context.Context, uuid, requester int) error
+	RejectFollowRequest(ctx context.Context, uuid, requester int) error
+	Mute(ctx context.Context, src, target int) error
+	Unmute(ctx context.Context, src, target int) error
+	ListMutuals(ctx context.Context, uuid, pageSize int, pageToken string) ([]models.Following, string, error)
+	CountMutuals(ctx context.Context, uuid int) (int, error)
+}
+type Suggester interface {
+	SuggestFollows(ctx context.Context, uuid, limit int) ([]models.Suggestion, error)
+}
+type PathFinder interface {
+	FollowPath(ctx context.Context, src, dst, depth int) ([]int, error)
+}
+type Admin interface {
+	DeleteUserGraph(ctx context.Context, uuid int) (models.DeletedGraph, error)
+}
+type Watcher interface {
+	WatchFollows(
+		ctx context.Context,
+		uuid int,
+		resumeToken string,
+		send func(event models.FollowEvent, resumeToken string) error,
+	) error
+	WatchAllFollows(
+		ctx context.Context,
+		resumeToken string,
+		send func(event models.FollowEvent, resumeToken string) error,
+	) error
+	Stop()
+}
+type Idempotency interface {
+	Outcome(ctx context.Context, caller int, key, fingerprint string) (models.IdempotencyKey, bool, error)
+	Save(ctx context.Context, caller int, outcome models.IdempotencyKey) (models.IdempotencyKey, error)
 }
 
+// New returns new grpc application. Administrative API is served on adminPort, so it can be kept
+// unreachable by end users. Zero adminPort disables administrative API
 func New(
 	log *slog.Logger,
-	port int,
+	port, adminPort int,
 	srvc Service,
+	sgst Suggester,
+	pthf PathFinder,
+	adm Admin,
+	wtch Watcher,
+	idmp Idempotency,
 ) *App {
+	grpcsrv := newServer(log)
+	grpcfllw.Register(grpcsrv, srvc, sgst, pthf, wtch, idmp)
+
+	var adminsrv *grpc.Server
+	if adminPort != 0 {
+		adminsrv = newServer(log)
+		grpcfllw.RegisterAdmin(adminsrv, adm, wtch)
+	}
+
+	return &App{
+		log:       log,
+		gRPCSrv:   grpcsrv,
+		adminSrv:  adminsrv,
+		wtch:      wtch,
+		port:      port,
+		adminPort: adminPort,
+	}
+}
+
+// newServer returns grpc server with recovery and logging interceptors
+func newServer(log *slog.Logger) *grpc.Server {
 	loggingOpts := []logging.Option{
 		logging.WithLogOnEvents(
 			logging.PayloadReceived, logging.PayloadSent,
@@ -42,7 +132,7 @@ func New(
 		}),
 	}
 
-	grpcsrv := grpc.NewServer(
+	return grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
 			recovery.UnaryServerInterceptor(
 				recoveryOpts...,
@@ -51,17 +141,21 @@ func New(
 				logInterceptor(log), loggingOpts...,
 			),
 		),
+		grpc.ChainStreamInterceptor(
+			recovery.StreamServerInterceptor(
+				recoveryOpts...,
+			),
+			logging.StreamServerInterceptor(
+				logInterceptor(log), loggingOpts...,
+			),
+		),
 	)
-
-	grpcfllw.Register(grpcsrv, srvc)
-
-	return &App{log: log, gRPCSrv: grpcsrv, port: port}
 }
 
 // logInterceptor is wrapper for logger to enable convenient my logger for grpc interceptor
 func logInterceptor(log *slog.Logger) logging.Logger {
 	return logging.LoggerFunc(func(ctx context.Context, level logging.Level, msg string, fields ...any) {
-		log.Log(ctx, slog.Level(level), msg, fields)
+		log.Log(ctx, slog.Level(level), msg, fields...)
 	})
 }
 
@@ -84,6 +178,21 @@ func (a *App) Run() error {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
+	if a.adminSrv != nil {
+		adminLis, err := net.Listen("tcp", fmt.Sprintf(":%d", a.adminPort))
+		if err != nil {
+			lis.Close()
+			log.Error("failed to listen admin socket", sl.Err(err))
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		go func() {
+			if err := a.adminSrv.Serve(adminLis); err != nil {
+				log.Error("failed to serve admin api", sl.Err(err))
+			}
+		}()
+	}
+
 	if err = a.gRPCSrv.Serve(lis); err != nil {
 		log.Error("failed to serve", sl.Err(err))
 		return fmt.Errorf("%s: %w", op, err)
@@ -98,5 +207,10 @@ func (a *App) Stop() {
 
 	a.log.Info("stopping grpc application")
 
+	// watch streams never end by themselves, so they are finished first to let graceful stop complete
+	a.wtch.Stop()
+	if a.adminSrv != nil {
+		a.adminSrv.GracefulStop()
+	}
 	a.gRPCSrv.GracefulStop()
 }
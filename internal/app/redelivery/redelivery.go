@@ -0,0 +1,90 @@
+package redeliveryapp
+
+import (
+	"context"
+	"github.com/IlianBuh/Follow_Service/internal/lib/logger/sl"
+	"log/slog"
+	"time"
+)
+
+type Redeliverer interface {
+	Redeliver(ctx context.Context, limit int) (bool, error)
+}
+
+type App struct {
+	log       *slog.Logger
+	rdl       Redeliverer
+	interval  time.Duration
+	batchSize int
+	ctx       context.Context
+	cancel    context.CancelFunc
+	done      chan struct{}
+}
+
+// New returns new redelivery application which retries failed deliveries of events.
+// Due deliveries are polled every interval by batches of 'batchSize'
+func New(
+	log *slog.Logger,
+	rdl Redeliverer,
+	interval time.Duration,
+	batchSize int,
+) *App {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &App{
+		log:       log,
+		rdl:       rdl,
+		interval:  interval,
+		batchSize: batchSize,
+		ctx:       ctx,
+		cancel:    cancel,
+		done:      make(chan struct{}),
+	}
+}
+
+// MustRun starts redelivering and throw panic if error occurred
+func (a *App) MustRun() {
+	if err := a.Run(); err != nil {
+		panic("failed to run redelivery application: " + err.Error())
+	}
+}
+
+// Run retries deliveries until the application is stopped. Deliveries interrupted
+// by stopping are retried when their lease expires
+func (a *App) Run() error {
+	const op = "redeliveryapp.Run"
+	log := a.log.With(slog.String("op", op))
+	log.Info("starting redelivery application")
+
+	defer close(a.done)
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-a.ctx.Done():
+			return nil
+		case <-timer.C:
+		}
+
+		full, err := a.rdl.Redeliver(a.ctx, a.batchSize)
+		if err != nil {
+			log.Error("failed to redeliver events", sl.Err(err))
+		}
+
+		if full && err == nil {
+			timer.Reset(0)
+			continue
+		}
+		timer.Reset(a.interval)
+	}
+}
+
+// Stop stops redelivering and waits for the batch being delivered
+func (a *App) Stop() {
+	a.log.Info("stopping redelivery application")
+
+	a.cancel()
+	<-a.done
+}
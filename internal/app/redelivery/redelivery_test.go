@@ -0,0 +1,36 @@
+package redeliveryapp_test
+
+import (
+	"context"
+	redeliveryapp "github.com/IlianBuh/Follow_Service/internal/app/redelivery"
+	"github.com/stretchr/testify/require"
+	"io"
+	"log/slog"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// batches redelivers 'full' full batches and then empty ones
+type batches struct {
+	full  int32
+	calls atomic.Int32
+}
+
+func (b *batches) Redeliver(_ context.Context, _ int) (bool, error) {
+	return b.calls.Add(1) <= b.full, nil
+}
+
+func TestFullBatchesAreRedeliveredWithoutWaiting(t *testing.T) {
+	rdl := &batches{full: 3}
+	app := redeliveryapp.New(slog.New(slog.NewTextHandler(io.Discard, nil)), rdl, time.Hour, 10)
+	go app.MustRun()
+
+	// full batches are followed immediately, the first not full one waits for the interval
+	require.Eventually(t, func() bool {
+		return rdl.calls.Load() == 4
+	}, time.Second, time.Millisecond)
+
+	app.Stop()
+	require.EqualValues(t, 4, rdl.calls.Load())
+}
@@ -0,0 +1,188 @@
+package relayapp
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"github.com/IlianBuh/Follow_Service/internal/domain/models"
+	"github.com/IlianBuh/Follow_Service/internal/lib/logger/sl"
+	"log/slog"
+	"time"
+)
+
+type Publisher interface {
+	Publish(ctx context.Context, event models.FollowEvent) error
+}
+type OutboxProvider interface {
+	SequenceEvents(ctx context.Context) error
+	ClaimEvents(ctx context.Context, owner string, leasedUntil time.Time, limit int) ([]models.FollowEvent, error)
+	MarkPublished(ctx context.Context, ids []int) error
+	DeletePublishedEvents(ctx context.Context, before time.Time, limit int) (int, error)
+}
+
+// cleanupInterval is the period of deleting published events older than the retention
+const cleanupInterval = time.Hour
+
+type App struct {
+	log       *slog.Logger
+	outbox    OutboxProvider
+	pub       Publisher
+	interval  time.Duration
+	batchSize int
+	lease     time.Duration
+	retention time.Duration
+	// cleanedAt is the moment of the last cleanup of published events
+	cleanedAt time.Time
+	// owner identifies the relay among replicas sharing the outbox
+	owner  string
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// New returns new relay application which publishes events from the outbox.
+// The outbox is polled every interval, events are claimed by batches of 'batchSize'
+// and leased to the relay for 'lease', so replicas sharing the outbox do not publish
+// the same events. The lease should be longer than publishing of the batch.
+// Published events are deleted after 'retention', zero retention keeps them forever
+func New(
+	log *slog.Logger,
+	outbox OutboxProvider,
+	pub Publisher,
+	interval time.Duration,
+	batchSize int,
+	lease, retention time.Duration,
+) *App {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &App{
+		log:       log,
+		outbox:    outbox,
+		pub:       pub,
+		interval:  interval,
+		batchSize: batchSize,
+		lease:     lease,
+		retention: retention,
+		owner:     rand.Text(),
+		ctx:       ctx,
+		cancel:    cancel,
+		done:      make(chan struct{}),
+	}
+}
+
+// MustRun starts relaying and throw panic if error occurred
+func (a *App) MustRun() {
+	if err := a.Run(); err != nil {
+		panic("failed to run relay application: " + err.Error())
+	}
+}
+
+// Run relays events until the application is stopped. Events are published at least once,
+// events of one batch are published in order they were emitted. Failed event is retried on
+// the next poll together with all events after it. Events of a stopped relay are claimed by
+// other replicas when their lease expires
+func (a *App) Run() error {
+	const op = "relayapp.Run"
+	log := a.log.With(slog.String("op", op))
+	log.Info("starting relay application")
+
+	defer close(a.done)
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-a.ctx.Done():
+			return nil
+		case <-timer.C:
+		}
+
+		full, err := a.relay(a.ctx)
+		if err != nil {
+			log.Error("failed to relay events", sl.Err(err))
+		}
+
+		if a.retention > 0 && time.Since(a.cleanedAt) >= cleanupInterval {
+			if cleanErr := a.cleanup(a.ctx); cleanErr != nil {
+				log.Error("failed to delete published events", sl.Err(cleanErr))
+			}
+			a.cleanedAt = time.Now()
+		}
+
+		if full && err == nil {
+			timer.Reset(0)
+			continue
+		}
+		timer.Reset(a.interval)
+	}
+}
+
+// Stop stops relaying and waits for the batch being published
+func (a *App) Stop() {
+	a.log.Info("stopping relay application")
+
+	a.cancel()
+	<-a.done
+}
+
+// relay sequences committed events for watchers and publishes one batch of events.
+// It reports whether the batch was full, i.e. there may be more events to publish
+func (a *App) relay(ctx context.Context) (bool, error) {
+	const op = "relayapp.relay"
+
+	if err := a.outbox.SequenceEvents(ctx); err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	events, err := a.outbox.ClaimEvents(ctx, a.owner, time.Now().Add(a.lease), a.batchSize)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	published := make([]int, 0, len(events))
+	var pubErr error
+	for _, v := range events {
+		if pubErr = a.pub.Publish(ctx, v); pubErr != nil {
+			break
+		}
+
+		published = append(published, v.ID)
+	}
+
+	// published events are marked even if the application is being stopped
+	err = a.outbox.MarkPublished(context.WithoutCancel(ctx), published)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	if pubErr != nil {
+		return false, fmt.Errorf("%s: %w", op, pubErr)
+	}
+
+	return len(events) == a.batchSize, nil
+}
+
+// cleanup deletes events published before the retention by batches
+func (a *App) cleanup(ctx context.Context) error {
+	const op = "relayapp.cleanup"
+
+	before := time.Now().Add(-a.retention)
+	total := 0
+	for {
+		deleted, err := a.outbox.DeletePublishedEvents(ctx, before, a.batchSize)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		total += deleted
+		if deleted < a.batchSize {
+			break
+		}
+	}
+
+	if total > 0 {
+		a.log.Info("published events are deleted", slog.String("op", op), slog.Int("count", total))
+	}
+
+	return nil
+}
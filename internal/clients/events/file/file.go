@@ -0,0 +1,126 @@
+package file
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/IlianBuh/Follow_Service/internal/domain/models"
+	"github.com/IlianBuh/Follow_Service/internal/lib/logger/sl"
+	"io"
+	"log/slog"
+	"os"
+	"time"
+)
+
+// event is the line of the events file
+type event struct {
+	Type string `json:"type"`
+	UUID int    `json:"uuid"`
+}
+
+// Source reads user events from the file with one JSON object per line, e.g.
+// {"type":"user.deleted","uuid":42}. The file is polled for new lines every interval.
+// Reading starts from the beginning of the file, so events are redelivered after restart
+type Source struct {
+	log      *slog.Logger
+	path     string
+	interval time.Duration
+}
+
+// New returns new file source of user events
+func New(
+	log *slog.Logger,
+	path string,
+	interval time.Duration,
+) *Source {
+	return &Source{
+		log:      log,
+		path:     path,
+		interval: interval,
+	}
+}
+
+// Subscribe passes events appended to the file to 'handle' until ctx is done. Malformed lines
+// are skipped. Events failed to be handled are not redelivered
+func (s *Source) Subscribe(ctx context.Context, handle func(context.Context, models.UserEvent) error) error {
+	const op = "file.Subscribe"
+	log := s.log.With(slog.String("op", op))
+
+	f, err := s.open(ctx)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return nil
+		}
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer f.Close()
+
+	reader := bufio.NewReader(f)
+	var line []byte
+	for {
+		part, err := reader.ReadBytes('\n')
+		line = append(line, part...)
+
+		if errors.Is(err, io.EOF) {
+			if !s.wait(ctx) {
+				return nil
+			}
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		if len(bytes.TrimSpace(line)) == 0 {
+			line = line[:0]
+			continue
+		}
+
+		var ev event
+		if err = json.Unmarshal(line, &ev); err != nil {
+			log.Warn("malformed event is skipped", slog.String("line", string(bytes.TrimSpace(line))), sl.Err(err))
+			line = line[:0]
+			continue
+		}
+		line = line[:0]
+
+		userEvent := models.UserEvent{Type: models.UserEventType(ev.Type), UUID: ev.UUID}
+		if err = handle(ctx, userEvent); err != nil {
+			log.Warn("event is not handled", slog.Any("event", userEvent), sl.Err(err))
+		}
+	}
+}
+
+// open opens the events file. If the file does not exist, it waits until the file is created
+func (s *Source) open(ctx context.Context) (*os.File, error) {
+	for {
+		f, err := os.Open(s.path)
+		if err == nil {
+			return f, nil
+		}
+		if !errors.Is(err, os.ErrNotExist) {
+			return nil, err
+		}
+
+		if !s.wait(ctx) {
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// wait sleeps for the poll interval. It returns false if ctx is done earlier
+func (s *Source) wait(ctx context.Context) bool {
+	timer := time.NewTimer(s.interval)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
@@ -0,0 +1,51 @@
+package memory
+
+import (
+	"context"
+	"github.com/IlianBuh/Follow_Service/internal/domain/models"
+	"github.com/IlianBuh/Follow_Service/internal/lib/logger/sl"
+	"log/slog"
+)
+
+// Source is in-process source of user events. Events are passed by Publish
+// and delivered to the subscriber in the same order
+type Source struct {
+	log    *slog.Logger
+	events chan models.UserEvent
+}
+
+// New returns new in-process source which buffers at most 'size' events
+func New(log *slog.Logger, size int) *Source {
+	return &Source{
+		log:    log,
+		events: make(chan models.UserEvent, size),
+	}
+}
+
+// Publish passes the event to the subscriber. It blocks while the buffer is full
+func (s *Source) Publish(ctx context.Context, event models.UserEvent) error {
+	select {
+	case s.events <- event:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Subscribe passes published events to 'handle' until ctx is done.
+// Events failed to be handled are not redelivered
+func (s *Source) Subscribe(ctx context.Context, handle func(context.Context, models.UserEvent) error) error {
+	const op = "memory.Subscribe"
+	log := s.log.With(slog.String("op", op))
+
+	for {
+		select {
+		case event := <-s.events:
+			if err := handle(ctx, event); err != nil {
+				log.Warn("event is not handled", slog.Any("event", event), sl.Err(err))
+			}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
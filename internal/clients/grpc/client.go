@@ -50,18 +50,24 @@ func New(
 	}, nil
 }
 
-func (c *Client) CheckUsers(ctx context.Context, uuids []int) (bool, error) {
-	const op = "grpclient.CheckUsers"
+// ExistingUsers returns those of uuids which belong to existing users
+func (c *Client) ExistingUsers(ctx context.Context, uuids []int) ([]int, error) {
+	const op = "grpclient.ExistingUsers"
 
-	res, err := c.gRPClient.UsersExist(
+	res, err := c.gRPClient.Users(
 		ctx,
-		&userinfov1.UsersExistRequest{
-			Uuid: mappers.IntToInt32(uuids...),
+		&userinfov1.UsersRequest{
+			Uuids: mappers.IntToInt32(uuids...),
 		},
 	)
 	if err != nil {
-		return false, fmt.Errorf("%s: %w", op, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	return res.GetExist(), nil
+	existing := make([]int, len(res.GetUsers()))
+	for i, v := range res.GetUsers() {
+		existing[i] = int(v.GetUuid())
+	}
+
+	return existing, nil
 }
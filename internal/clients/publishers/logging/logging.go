@@ -0,0 +1,34 @@
+package logging
+
+import (
+	"context"
+	"github.com/IlianBuh/Follow_Service/internal/domain/models"
+	"log/slog"
+)
+
+// Publisher writes follow events to the log. It is used when no other publisher is configured
+type Publisher struct {
+	log *slog.Logger
+}
+
+// New returns new logging publisher
+func New(log *slog.Logger) *Publisher {
+	return &Publisher{
+		log: log,
+	}
+}
+
+// Publish writes the event to the log
+func (p *Publisher) Publish(ctx context.Context, event models.FollowEvent) error {
+	p.log.InfoContext(
+		ctx,
+		"follow event",
+		slog.Int("id", event.ID),
+		slog.String("type", string(event.Type)),
+		slog.Int("follower", event.Follower),
+		slog.Int("followee", event.Followee),
+		slog.Time("created_at", event.CreatedAt),
+	)
+
+	return nil
+}
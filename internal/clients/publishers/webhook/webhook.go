@@ -0,0 +1,356 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/IlianBuh/Follow_Service/internal/domain/models"
+	"github.com/IlianBuh/Follow_Service/internal/lib/logger/sl"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	headerSignature = "X-Follow-Signature"
+	headerTimestamp = "X-Follow-Timestamp"
+	headerEventID   = "X-Follow-Event-Id"
+)
+
+type DeliveriesStorage interface {
+	SaveDeadLetter(ctx context.Context, letter models.DeadLetter) error
+	SaveRetry(ctx context.Context, delivery models.Delivery) error
+	ClaimRetries(ctx context.Context, leasedUntil time.Time, limit int) ([]models.Delivery, error)
+	UpdateRetry(ctx context.Context, delivery models.Delivery) error
+	DeleteRetry(ctx context.Context, id int) error
+}
+
+// Endpoint is the receiver of webhooks. Requests to it are signed by Secret
+type Endpoint struct {
+	URL    string
+	Secret string
+}
+
+// Options configures delivery retries. Delay before the n-th retry is
+// BaseBackoff * 2^(n-1), but not more than MaxBackoff
+type Options struct {
+	Timeout     time.Duration
+	MaxAttempts int
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+}
+
+// Publisher posts follow events as signed JSON to every endpoint
+type Publisher struct {
+	log       *slog.Logger
+	client    *http.Client
+	endpoints []Endpoint
+	// byURL is the index of the endpoint by its url
+	byURL map[string]int
+	// failing marks endpoints which failed the last attempt. Events for them are queued
+	// for retry without attempting, so a dead endpoint does not slow down publishing
+	failing []atomic.Bool
+	opts    Options
+	dlvStr  DeliveriesStorage
+}
+
+// payload is the body of the webhook request
+type payload struct {
+	ID        int       `json:"id"`
+	Type      string    `json:"type"`
+	Follower  int       `json:"follower"`
+	Followee  int       `json:"followee"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+var (
+	// errPermanent marks delivery failures which are not retried
+	errPermanent = errors.New("permanent failure")
+	// errFailing is the reason of deliveries queued without attempting
+	errFailing = errors.New("endpoint is failing")
+)
+
+// New returns new webhook publisher. Failed deliveries are queued in dlvStr
+// and retried by Redeliver
+func New(
+	log *slog.Logger,
+	endpoints []Endpoint,
+	opts Options,
+	dlvStr DeliveriesStorage,
+) *Publisher {
+	byURL := make(map[string]int, len(endpoints))
+	for i, v := range endpoints {
+		byURL[v.URL] = i
+	}
+
+	return &Publisher{
+		log:       log,
+		client:    &http.Client{Timeout: opts.Timeout},
+		endpoints: endpoints,
+		byURL:     byURL,
+		failing:   make([]atomic.Bool, len(endpoints)),
+		opts:      opts,
+		dlvStr:    dlvStr,
+	}
+}
+
+// Publish delivers the event to every endpoint. Endpoint's request body is signed by
+// HMAC-SHA256 of "<timestamp>.<body>" with the endpoint secret, the signature is passed
+// as "sha256=<hex digest>" in X-Follow-Signature header and the unix timestamp in
+// X-Follow-Timestamp header. Endpoints are attempted once and concurrently, failed deliveries
+// are queued for retry and deliveries which failed permanently are saved as dead letters.
+// Error is returned only if the delivery was interrupted or could not be queued, so the event
+// may be delivered to some endpoints more than once and receivers should deduplicate events
+// by X-Follow-Event-Id header
+func (p *Publisher) Publish(ctx context.Context, event models.FollowEvent) error {
+	const op = "webhook.Publish"
+
+	body, err := json.Marshal(payload{
+		ID:        event.ID,
+		Type:      string(event.Type),
+		Follower:  event.Follower,
+		Followee:  event.Followee,
+		CreatedAt: event.CreatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	errs := make([]error, len(p.endpoints))
+	var wg sync.WaitGroup
+	for i, endpoint := range p.endpoints {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			errs[i] = p.publishTo(ctx, i, models.Delivery{EventID: event.ID, Endpoint: endpoint.URL, Payload: body})
+		}()
+	}
+	wg.Wait()
+
+	if err = errors.Join(errs...); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// Redeliver attempts due deliveries queued for retry. Deliveries are claimed by batches of 'limit',
+// deliveries to different endpoints are attempted concurrently. It reports whether the batch was
+// full, i.e. there may be more deliveries due
+func (p *Publisher) Redeliver(ctx context.Context, limit int) (bool, error) {
+	const op = "webhook.Redeliver"
+
+	// deliveries to one endpoint are attempted one by one, so the batch is leased for
+	// the time of all of them timing out
+	leasedUntil := time.Now().Add(time.Duration(limit) * p.opts.Timeout)
+	deliveries, err := p.dlvStr.ClaimRetries(ctx, leasedUntil, limit)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	byEndpoint := make(map[string][]models.Delivery)
+	for _, v := range deliveries {
+		byEndpoint[v.Endpoint] = append(byEndpoint[v.Endpoint], v)
+	}
+
+	var (
+		mu   sync.Mutex
+		errs []error
+		wg   sync.WaitGroup
+	)
+	for _, list := range byEndpoint {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if err := p.redeliverTo(ctx, list); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if err = errors.Join(errs...); err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return len(deliveries) == limit, nil
+}
+
+// publishTo makes the first attempt of the delivery to the endpoint with index i.
+// If the endpoint is failing, the delivery is queued for retry without attempting
+func (p *Publisher) publishTo(ctx context.Context, i int, delivery models.Delivery) error {
+	if p.failing[i].Load() {
+		delivery.Error = errFailing.Error()
+		delivery.NextAttemptAt = time.Now()
+
+		return p.dlvStr.SaveRetry(ctx, delivery)
+	}
+
+	delivery.Attempts = 1
+	err := p.post(ctx, p.endpoints[i], delivery.EventID, delivery.Payload)
+	if err == nil {
+		return nil
+	}
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	if !errors.Is(err, errPermanent) {
+		p.failing[i].Store(true)
+	}
+
+	return p.failed(ctx, delivery, err)
+}
+
+// redeliverTo attempts queued deliveries to one endpoint one by one. After the delivery failed,
+// the rest of them are postponed without attempting until the next attempt of the failed one
+func (p *Publisher) redeliverTo(ctx context.Context, deliveries []models.Delivery) error {
+	i, ok := p.byURL[deliveries[0].Endpoint]
+
+	for n, delivery := range deliveries {
+		delivery.Attempts++
+
+		err := fmt.Errorf("%w: endpoint is not configured", errPermanent)
+		if ok {
+			err = p.post(ctx, p.endpoints[i], delivery.EventID, delivery.Payload)
+		}
+		if err == nil {
+			p.failing[i].Store(false)
+
+			if err = p.dlvStr.DeleteRetry(ctx, delivery.ID); err != nil {
+				return err
+			}
+			continue
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		if failErr := p.failed(ctx, delivery, err); failErr != nil {
+			return failErr
+		}
+		if errors.Is(err, errPermanent) {
+			continue
+		}
+
+		p.failing[i].Store(true)
+		return p.postpone(ctx, deliveries[n+1:], time.Now().Add(p.backoff(delivery.Attempts)))
+	}
+
+	return nil
+}
+
+// failed records the failed attempt of the delivery. The delivery is retried after backoff,
+// unless the failure is permanent or attempts are exhausted, then it is saved as dead letter
+func (p *Publisher) failed(ctx context.Context, delivery models.Delivery, err error) error {
+	delivery.Error = err.Error()
+
+	if errors.Is(err, errPermanent) || delivery.Attempts >= p.opts.MaxAttempts {
+		p.log.Warn(
+			"event is not delivered",
+			slog.Int("event_id", delivery.EventID),
+			slog.String("endpoint", delivery.Endpoint),
+			slog.Int("attempts", delivery.Attempts),
+			sl.Err(err),
+		)
+
+		err = p.dlvStr.SaveDeadLetter(ctx, models.DeadLetter{
+			EventID:  delivery.EventID,
+			Endpoint: delivery.Endpoint,
+			Payload:  delivery.Payload,
+			Error:    delivery.Error,
+			Attempts: delivery.Attempts,
+		})
+		if err != nil || delivery.ID == 0 {
+			return err
+		}
+
+		return p.dlvStr.DeleteRetry(ctx, delivery.ID)
+	}
+
+	delivery.NextAttemptAt = time.Now().Add(p.backoff(delivery.Attempts))
+	if delivery.ID == 0 {
+		return p.dlvStr.SaveRetry(ctx, delivery)
+	}
+
+	return p.dlvStr.UpdateRetry(ctx, delivery)
+}
+
+// postpone moves the next attempt of the deliveries to the moment
+func (p *Publisher) postpone(ctx context.Context, deliveries []models.Delivery, moment time.Time) error {
+	for _, delivery := range deliveries {
+		delivery.NextAttemptAt = moment
+		if err := p.dlvStr.UpdateRetry(ctx, delivery); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// post makes one delivery attempt. Client errors except timeouts and throttling
+// are permanent failures
+func (p *Publisher) post(ctx context.Context, endpoint Endpoint, eventID int, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("%w: %w", errPermanent, err)
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(headerEventID, strconv.Itoa(eventID))
+	req.Header.Set(headerTimestamp, timestamp)
+	req.Header.Set(headerSignature, sign(endpoint.Secret, timestamp, body))
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return nil
+	case resp.StatusCode == http.StatusRequestTimeout || resp.StatusCode == http.StatusTooManyRequests:
+		return fmt.Errorf("unexpected status: %s", resp.Status)
+	case resp.StatusCode >= 400 && resp.StatusCode < 500:
+		return fmt.Errorf("%w: unexpected status: %s", errPermanent, resp.Status)
+	}
+
+	return fmt.Errorf("unexpected status: %s", resp.Status)
+}
+
+// backoff returns delay before the retry following the attempt
+func (p *Publisher) backoff(attempt int) time.Duration {
+	delay := p.opts.BaseBackoff
+	for i := 1; i < attempt && delay < p.opts.MaxBackoff; i++ {
+		delay *= 2
+	}
+
+	return min(delay, p.opts.MaxBackoff)
+}
+
+// sign returns the signature "sha256=<hex digest>", where the digest is HMAC-SHA256
+// of "<timestamp>.<body>" with the secret
+func sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,269 @@
+package webhook_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/IlianBuh/Follow_Service/internal/clients/publishers/webhook"
+	"github.com/IlianBuh/Follow_Service/internal/domain/models"
+	"github.com/stretchr/testify/require"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// deliveries keeps dead letters and retries in memory
+type deliveries struct {
+	mu          sync.Mutex
+	deadLetters []models.DeadLetter
+	retries     map[int]models.Delivery
+	lastID      int
+}
+
+func newDeliveries() *deliveries {
+	return &deliveries{retries: make(map[int]models.Delivery)}
+}
+
+func (d *deliveries) SaveDeadLetter(_ context.Context, letter models.DeadLetter) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.deadLetters = append(d.deadLetters, letter)
+	return nil
+}
+
+func (d *deliveries) SaveRetry(_ context.Context, delivery models.Delivery) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.lastID++
+	delivery.ID = d.lastID
+	d.retries[delivery.ID] = delivery
+	return nil
+}
+
+func (d *deliveries) ClaimRetries(_ context.Context, leasedUntil time.Time, limit int) ([]models.Delivery, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	ids := make([]int, 0, len(d.retries))
+	for id := range d.retries {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	res := make([]models.Delivery, 0, limit)
+	for _, id := range ids {
+		delivery := d.retries[id]
+		if len(res) == limit || delivery.NextAttemptAt.After(time.Now()) {
+			continue
+		}
+
+		delivery.NextAttemptAt = leasedUntil
+		d.retries[id] = delivery
+		res = append(res, delivery)
+	}
+
+	return res, nil
+}
+
+func (d *deliveries) UpdateRetry(_ context.Context, delivery models.Delivery) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.retries[delivery.ID] = delivery
+	return nil
+}
+
+func (d *deliveries) DeleteRetry(_ context.Context, id int) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	delete(d.retries, id)
+	return nil
+}
+
+func (d *deliveries) DeadLetters() []models.DeadLetter {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return slices.Clone(d.deadLetters)
+}
+
+func (d *deliveries) Retries() []models.Delivery {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	res := make([]models.Delivery, 0, len(d.retries))
+	for _, v := range d.retries {
+		res = append(res, v)
+	}
+
+	return res
+}
+
+// receiver is the webhook endpoint answering with the configured status
+type receiver struct {
+	*httptest.Server
+	status   atomic.Int32
+	requests atomic.Int32
+	header   chan http.Header
+	body     chan []byte
+}
+
+func newReceiver(t *testing.T, status int) *receiver {
+	r := &receiver{
+		header: make(chan http.Header, 16),
+		body:   make(chan []byte, 16),
+	}
+	r.status.Store(int32(status))
+	r.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		r.requests.Add(1)
+
+		select {
+		case r.header <- req.Header.Clone():
+			r.body <- body
+		default:
+		}
+
+		w.WriteHeader(int(r.status.Load()))
+	}))
+	t.Cleanup(r.Close)
+
+	return r
+}
+
+func newPublisher(dlv *deliveries, endpoints ...webhook.Endpoint) *webhook.Publisher {
+	return webhook.New(
+		slog.New(slog.NewTextHandler(io.Discard, nil)),
+		endpoints,
+		webhook.Options{
+			Timeout:     time.Second,
+			MaxAttempts: 3,
+			BaseBackoff: time.Millisecond,
+			MaxBackoff:  time.Millisecond,
+		},
+		dlv,
+	)
+}
+
+var event = models.FollowEvent{
+	ID:        42,
+	Type:      models.FollowEventFollowed,
+	Follower:  1,
+	Followee:  2,
+	CreatedAt: time.Unix(1700000000, 0).UTC(),
+}
+
+func TestPublishSignsRequest(t *testing.T) {
+	const secret = "secret"
+	rcv := newReceiver(t, http.StatusOK)
+	dlv := newDeliveries()
+	pub := newPublisher(dlv, webhook.Endpoint{URL: rcv.URL, Secret: secret})
+
+	require.NoError(t, pub.Publish(context.Background(), event))
+
+	header, body := <-rcv.header, <-rcv.body
+	require.Equal(t, strconv.Itoa(event.ID), header.Get("X-Follow-Event-Id"))
+
+	timestamp := header.Get("X-Follow-Timestamp")
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now(), time.Unix(unix, 0), time.Minute)
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(body)
+	require.Equal(t, "sha256="+hex.EncodeToString(mac.Sum(nil)), header.Get("X-Follow-Signature"))
+
+	require.JSONEq(
+		t,
+		`{"id":42,"type":"followed","follower":1,"followee":2,"created_at":"2023-11-14T22:13:20Z"}`,
+		string(body),
+	)
+	require.Empty(t, dlv.DeadLetters())
+	require.Empty(t, dlv.Retries())
+}
+
+func TestClientErrorIsDeadLettered(t *testing.T) {
+	rcv := newReceiver(t, http.StatusBadRequest)
+	dlv := newDeliveries()
+	pub := newPublisher(dlv, webhook.Endpoint{URL: rcv.URL, Secret: "secret"})
+
+	require.NoError(t, pub.Publish(context.Background(), event))
+
+	require.Empty(t, dlv.Retries())
+	letters := dlv.DeadLetters()
+	require.Len(t, letters, 1)
+	require.Equal(t, event.ID, letters[0].EventID)
+	require.Equal(t, rcv.URL, letters[0].Endpoint)
+	require.Equal(t, 1, letters[0].Attempts)
+	require.Contains(t, letters[0].Error, "400")
+}
+
+func TestServerErrorIsRetriedThenDeadLettered(t *testing.T) {
+	rcv := newReceiver(t, http.StatusInternalServerError)
+	dlv := newDeliveries()
+	pub := newPublisher(dlv, webhook.Endpoint{URL: rcv.URL, Secret: "secret"})
+	ctx := context.Background()
+
+	require.NoError(t, pub.Publish(ctx, event))
+
+	retries := dlv.Retries()
+	require.Len(t, retries, 1)
+	require.Equal(t, 1, retries[0].Attempts)
+	require.Empty(t, dlv.DeadLetters())
+
+	for attempts := 2; attempts <= 3; attempts++ {
+		time.Sleep(2 * time.Millisecond)
+		_, err := pub.Redeliver(ctx, 10)
+		require.NoError(t, err)
+	}
+
+	require.Empty(t, dlv.Retries())
+	letters := dlv.DeadLetters()
+	require.Len(t, letters, 1)
+	require.Equal(t, 3, letters[0].Attempts)
+	require.Contains(t, letters[0].Error, "500")
+	require.EqualValues(t, 3, rcv.requests.Load())
+}
+
+func TestRedeliveryClearsFailing(t *testing.T) {
+	rcv := newReceiver(t, http.StatusServiceUnavailable)
+	dlv := newDeliveries()
+	pub := newPublisher(dlv, webhook.Endpoint{URL: rcv.URL, Secret: "secret"})
+	ctx := context.Background()
+
+	require.NoError(t, pub.Publish(ctx, event))
+	require.EqualValues(t, 1, rcv.requests.Load())
+
+	// the endpoint is failing, so the next event is queued without attempting
+	next := event
+	next.ID++
+	require.NoError(t, pub.Publish(ctx, next))
+	require.EqualValues(t, 1, rcv.requests.Load())
+	require.Len(t, dlv.Retries(), 2)
+
+	rcv.status.Store(http.StatusOK)
+	time.Sleep(2 * time.Millisecond)
+	_, err := pub.Redeliver(ctx, 10)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, rcv.requests.Load())
+	require.Empty(t, dlv.Retries())
+
+	// the endpoint is attempted directly again
+	next.ID++
+	require.NoError(t, pub.Publish(ctx, next))
+	require.EqualValues(t, 4, rcv.requests.Load())
+	require.Empty(t, dlv.Retries())
+	require.Empty(t, dlv.DeadLetters())
+}
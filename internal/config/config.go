@@ -1,25 +1,117 @@
 package config
 
 import (
+	"encoding/json"
 	"flag"
+	"fmt"
 	"github.com/ilyakaznacheev/cleanenv"
+	"log/slog"
 	"os"
 	"time"
 )
 
 type Config struct {
-	Env          string  `yaml:"env" env-default:"prod"`
-	StorageURL   string  `yaml:"storage-url" env-required:"true"`
-	GRPC         GRPCObj `yaml:"grpc"`
-	UserInfoPort int     `yaml:"user-info-port" env-required:"true"`
+	Env           string         `yaml:"env" env-default:"prod"`
+	StorageDriver string         `yaml:"storage-driver" env-default:"sqlite"`
+	StorageURL    string         `yaml:"storage-url" env-required:"true"`
+	GRPC          GRPCObj        `yaml:"grpc"`
+	UserInfoPort  int            `yaml:"user-info-port" env-required:"true"`
+	Migrations    MigrationsObj  `yaml:"migrations"`
+	Events        EventsObj      `yaml:"events"`
+	Outbox        OutboxObj      `yaml:"outbox"`
+	Watch         WatchObj       `yaml:"watch"`
+	Idempotency   IdempotencyObj `yaml:"idempotency"`
+	Limits        LimitsObj      `yaml:"limits"`
 }
 
 type GRPCObj struct {
 	Port       int           `yaml:"port" env-default:"20202"`
+	AdminPort  int           `yaml:"admin-port"`
 	Timeout    time.Duration `yaml:"timeout" env-default:"5s"`
 	RetryCount int           `yaml:"retry-count" env-default:"5"`
 }
 
+type MigrationsObj struct {
+	OnStart bool `yaml:"on-start" env-default:"true"`
+}
+
+// EventsObj configures the source of user lifecycle events. Empty source disables consuming
+type EventsObj struct {
+	Source       string        `yaml:"source"`
+	Path         string        `yaml:"path"`
+	PollInterval time.Duration `yaml:"poll-interval" env-default:"1s"`
+	BaseBackoff  time.Duration `yaml:"base-backoff" env-default:"500ms"`
+	MaxBackoff   time.Duration `yaml:"max-backoff" env-default:"30s"`
+}
+
+// OutboxObj configures relaying of follow events from the outbox to the publisher
+type OutboxObj struct {
+	Publisher    string        `yaml:"publisher" env-default:"log"`
+	PollInterval time.Duration `yaml:"poll-interval" env-default:"1s"`
+	BatchSize    int           `yaml:"batch-size" env-default:"100"`
+	Lease        time.Duration `yaml:"lease" env-default:"5m"`
+	Retention    time.Duration `yaml:"retention" env-default:"168h"`
+	Webhook      WebhookObj    `yaml:"webhook"`
+}
+
+// WebhookObj configures delivery of follow events to HTTP endpoints
+type WebhookObj struct {
+	Endpoints   []EndpointObj `yaml:"endpoints"`
+	Timeout     time.Duration `yaml:"timeout" env-default:"5s"`
+	MaxAttempts int           `yaml:"max-attempts" env-default:"5"`
+	BaseBackoff time.Duration `yaml:"base-backoff" env-default:"500ms"`
+	MaxBackoff  time.Duration `yaml:"max-backoff" env-default:"30s"`
+}
+
+// EndpointObj is the webhook receiver. Deliveries to it are signed by the secret
+type EndpointObj struct {
+	URL    string `yaml:"url"`
+	Secret string `yaml:"secret"`
+}
+
+// redactedSecret replaces the secrets of the endpoints in logs
+const redactedSecret = "REDACTED"
+
+// LogValue implements slog.LogValuer and hides the secret
+func (e EndpointObj) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("url", e.URL),
+		slog.String("secret", redactedSecret),
+	)
+}
+
+// String hides the secret when the endpoint is logged as the part of the config by text handler
+func (e EndpointObj) String() string {
+	return fmt.Sprintf("{URL:%s Secret:%s}", e.URL, redactedSecret)
+}
+
+// MarshalJSON hides the secret when the endpoint is logged as the part of the config by json handler
+func (e EndpointObj) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		URL    string
+		Secret string
+	}{URL: e.URL, Secret: redactedSecret})
+}
+
+// WatchObj configures polling of the outbox by watch streams
+type WatchObj struct {
+	PollInterval time.Duration `yaml:"poll-interval" env-default:"500ms"`
+	BatchSize    int           `yaml:"batch-size" env-default:"100"`
+}
+
+// IdempotencyObj configures storing of outcomes of requests with idempotency keys
+type IdempotencyObj struct {
+	TTL time.Duration `yaml:"ttl" env-default:"24h"`
+}
+
+// LimitsObj configures bounds of the requests. Zero follow limits disable them
+type LimitsObj struct {
+	MaxBatchSize     int `yaml:"max-batch-size" env-default:"100"`
+	FollowsPerMinute int `yaml:"follows-per-minute" env-default:"30"`
+	FollowsPerDay    int `yaml:"follows-per-day" env-default:"1000"`
+	MaxFollowees     int `yaml:"max-followees" env-default:"7500"`
+}
+
 const (
 	defaultConfigPath = "./config/config.yml"
 )
@@ -0,0 +1,29 @@
+package models
+
+// BulkStatus is the result of the bulk operation for one target
+type BulkStatus int
+
+const (
+	// BulkStatusFollowed means the following is created
+	BulkStatusFollowed BulkStatus = iota
+	// BulkStatusRequested means the target account is private and the follow request is created
+	BulkStatusRequested
+	// BulkStatusAlreadyFollowing means the following already existed
+	BulkStatusAlreadyFollowing
+	// BulkStatusAlreadyRequested means the follow request already existed
+	BulkStatusAlreadyRequested
+	// BulkStatusBlocked means one of the users blocked another one
+	BulkStatusBlocked
+	// BulkStatusInvalidUser means the target user does not exist
+	BulkStatusInvalidUser
+	// BulkStatusUnfollowed means the following is removed
+	BulkStatusUnfollowed
+	// BulkStatusNotFollowing means the following did not exist
+	BulkStatusNotFollowing
+)
+
+// BulkResult is the result of the bulk operation for the target with UUID
+type BulkResult struct {
+	UUID   int
+	Status BulkStatus
+}
@@ -0,0 +1,11 @@
+package models
+
+// DeadLetter is the event which could not be delivered to the endpoint
+type DeadLetter struct {
+	EventID  int
+	Endpoint string
+	Payload  []byte
+	// Error is the reason of the last failed attempt
+	Error    string
+	Attempts int
+}
@@ -0,0 +1,15 @@
+package models
+
+// DeletedGraph describes what was removed together with the user graph
+type DeletedGraph struct {
+	// Followers is number of removed followings of the user
+	Followers int
+	// Followees is number of removed followings by the user
+	Followees int
+	// Requests is number of removed follow requests sent or received by the user
+	Requests int
+	// Blocks is number of removed blocks made by the user or of the user
+	Blocks int
+	// Mutes is number of removed mutes made by the user or of the user
+	Mutes int
+}
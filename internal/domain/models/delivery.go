@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// Delivery is the event waiting for the next attempt of delivery to the endpoint
+type Delivery struct {
+	ID       int
+	EventID  int
+	Endpoint string
+	Payload  []byte
+	// Error is the reason of the last failed attempt
+	Error    string
+	Attempts int
+	// NextAttemptAt is the time after which the delivery is attempted again
+	NextAttemptAt time.Time
+}
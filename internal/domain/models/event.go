@@ -0,0 +1,39 @@
+package models
+
+import "time"
+
+// UserEventType is the type of the user lifecycle event
+type UserEventType string
+
+const (
+	// UserEventDeleted is published when the user account is deleted
+	UserEventDeleted UserEventType = "user.deleted"
+)
+
+// UserEvent is the user lifecycle event published by the identity service
+type UserEvent struct {
+	Type UserEventType
+	UUID int
+}
+
+// FollowEventType is the type of the follow domain event
+type FollowEventType string
+
+const (
+	// FollowEventFollowed is emitted when the following is created
+	FollowEventFollowed FollowEventType = "followed"
+	// FollowEventUnfollowed is emitted when the following is removed
+	FollowEventUnfollowed FollowEventType = "unfollowed"
+)
+
+// FollowEvent is the follow domain event stored in the outbox
+type FollowEvent struct {
+	ID int
+	// Seq is the position of the event in order events were committed to the outbox,
+	// zero until the event is sequenced
+	Seq       int
+	Type      FollowEventType
+	Follower  int
+	Followee  int
+	CreatedAt time.Time
+}
@@ -0,0 +1,13 @@
+package models
+
+import "time"
+
+// Following is an edge of the follow graph seen from one of its users
+type Following struct {
+	// ID is the storage identifier of the edge, it grows with every new following
+	ID int
+	// UUID is the other user of the edge: follower or followee
+	UUID int
+	// CreatedAt is the time the following was created. Zero if unknown
+	CreatedAt time.Time
+}
@@ -0,0 +1,14 @@
+package models
+
+import "time"
+
+// IdempotencyKey is the outcome of the request made with the idempotency key
+type IdempotencyKey struct {
+	Key string
+	// Fingerprint identifies the request, replays of the key must have the same one
+	Fingerprint string
+	// Status and Response are the serialized outcome of the request, empty Status means success
+	Status    []byte
+	Response  []byte
+	CreatedAt time.Time
+}
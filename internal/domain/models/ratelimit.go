@@ -0,0 +1,9 @@
+package models
+
+import "time"
+
+// RateLimit allows at most Count hits within any period of the Window
+type RateLimit struct {
+	Count  int
+	Window time.Duration
+}
@@ -0,0 +1,15 @@
+package models
+
+// Relationship describes following state between the viewer and the user with UUID
+type Relationship struct {
+	UUID int
+	// Following is true if the viewer follows the user
+	Following bool
+	// FollowedBy is true if the user follows the viewer
+	FollowedBy bool
+}
+
+// Mutual reports whether the viewer and the user follow each other
+func (r Relationship) Mutual() bool {
+	return r.Following && r.FollowedBy
+}
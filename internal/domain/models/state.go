@@ -0,0 +1,12 @@
+package models
+
+// FollowState is the result of the follow operation
+type FollowState int
+
+const (
+	// FollowStateFollowed means the following is created
+	FollowStateFollowed FollowState = iota
+	// FollowStateRequested means the target account is private and the follow request
+	// is waiting for its approval
+	FollowStateRequested
+)
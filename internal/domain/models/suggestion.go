@@ -0,0 +1,8 @@
+package models
+
+// Suggestion describes the user suggested to follow
+type Suggestion struct {
+	UUID int
+	// Paths is number of followees of the user who follow the suggested user
+	Paths int
+}
@@ -0,0 +1,87 @@
+package validator
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrInvalidUUID   = errors.New("uuid must be positive")
+	ErrSelfTarget    = errors.New("user can't target themselves")
+	ErrBatchTooLarge = errors.New("too many users in the batch")
+)
+
+// DefaultMaxBatchSize is used if the limit of the batch size is not positive
+const DefaultMaxBatchSize = 100
+
+// Limits are bounds of the requests
+type Limits struct {
+	// MaxBatchSize is maximum number of targets of the batch request.
+	// Non-positive value means DefaultMaxBatchSize
+	MaxBatchSize int
+}
+
+// Validator checks users passed to the services
+type Validator struct {
+	limits Limits
+}
+
+// New returns new validator with the limits
+func New(limits Limits) *Validator {
+	if limits.MaxBatchSize <= 0 {
+		limits.MaxBatchSize = DefaultMaxBatchSize
+	}
+
+	return &Validator{limits: limits}
+}
+
+// UUIDs checks that every uuid is positive
+func (v *Validator) UUIDs(uuids ...int) error {
+	for _, uuid := range uuids {
+		if uuid <= 0 {
+			return fmt.Errorf("%w: %d", ErrInvalidUUID, uuid)
+		}
+	}
+
+	return nil
+}
+
+// Pair checks the user src acting on the user target. The user can't act on themselves
+func (v *Validator) Pair(src, target int) error {
+	if err := v.UUIDs(src, target); err != nil {
+		return err
+	}
+	if src == target {
+		return fmt.Errorf("%w: %d", ErrSelfTarget, src)
+	}
+
+	return nil
+}
+
+// Batch checks the user src acting on every user of targets. The user can't act on themselves
+func (v *Validator) Batch(src int, targets []int) error {
+	if err := v.Lookup(src, targets); err != nil {
+		return err
+	}
+
+	for _, target := range targets {
+		if target == src {
+			return fmt.Errorf("%w: %d", ErrSelfTarget, src)
+		}
+	}
+
+	return nil
+}
+
+// Lookup checks the viewer looking up every user of targets. The viewer may be among targets
+func (v *Validator) Lookup(viewer int, targets []int) error {
+	if len(targets) > v.limits.MaxBatchSize {
+		return fmt.Errorf("%w: at most %d", ErrBatchTooLarge, v.limits.MaxBatchSize)
+	}
+
+	if err := v.UUIDs(viewer); err != nil {
+		return err
+	}
+
+	return v.UUIDs(targets...)
+}
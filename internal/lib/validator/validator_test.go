@@ -0,0 +1,109 @@
+package validator_test
+
+import (
+	"github.com/IlianBuh/Follow_Service/internal/lib/validator"
+	"github.com/stretchr/testify/require"
+	"slices"
+	"testing"
+)
+
+func TestUUIDs(t *testing.T) {
+	tests := []struct {
+		name  string
+		uuids []int
+		err   error
+	}{
+		{name: "none", uuids: nil},
+		{name: "positive", uuids: []int{1, 2, 3}},
+		{name: "zero", uuids: []int{1, 0}, err: validator.ErrInvalidUUID},
+		{name: "negative", uuids: []int{-1}, err: validator.ErrInvalidUUID},
+	}
+
+	v := validator.New(validator.Limits{MaxBatchSize: 3})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.ErrorIs(t, v.UUIDs(tt.uuids...), tt.err)
+		})
+	}
+}
+
+func TestPair(t *testing.T) {
+	tests := []struct {
+		name        string
+		src, target int
+		err         error
+	}{
+		{name: "valid", src: 1, target: 2},
+		{name: "invalid src", src: 0, target: 2, err: validator.ErrInvalidUUID},
+		{name: "invalid target", src: 1, target: -2, err: validator.ErrInvalidUUID},
+		{name: "self target", src: 1, target: 1, err: validator.ErrSelfTarget},
+		{name: "invalid self target", src: -1, target: -1, err: validator.ErrInvalidUUID},
+	}
+
+	v := validator.New(validator.Limits{MaxBatchSize: 3})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.ErrorIs(t, v.Pair(tt.src, tt.target), tt.err)
+		})
+	}
+}
+
+func TestBatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     int
+		targets []int
+		err     error
+	}{
+		{name: "empty", src: 1, targets: nil},
+		{name: "valid", src: 1, targets: []int{2, 3, 4}},
+		{name: "too large", src: 1, targets: []int{2, 3, 4, 5}, err: validator.ErrBatchTooLarge},
+		{name: "invalid src", src: 0, targets: []int{2}, err: validator.ErrInvalidUUID},
+		{name: "invalid target", src: 1, targets: []int{2, 0}, err: validator.ErrInvalidUUID},
+		{name: "self target", src: 1, targets: []int{2, 1}, err: validator.ErrSelfTarget},
+	}
+
+	v := validator.New(validator.Limits{MaxBatchSize: 3})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.ErrorIs(t, v.Batch(tt.src, tt.targets), tt.err)
+		})
+	}
+}
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		name    string
+		viewer  int
+		targets []int
+		err     error
+	}{
+		{name: "empty", viewer: 1, targets: nil},
+		{name: "valid", viewer: 1, targets: []int{2, 3}},
+		{name: "viewer among targets", viewer: 1, targets: []int{2, 1}},
+		{name: "too large", viewer: 1, targets: []int{2, 3, 4, 5}, err: validator.ErrBatchTooLarge},
+		{name: "invalid viewer", viewer: -1, targets: []int{2}, err: validator.ErrInvalidUUID},
+		{name: "invalid target", viewer: 1, targets: []int{0}, err: validator.ErrInvalidUUID},
+	}
+
+	v := validator.New(validator.Limits{MaxBatchSize: 3})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.ErrorIs(t, v.Lookup(tt.viewer, tt.targets), tt.err)
+		})
+	}
+}
+
+func TestDefaultMaxBatchSize(t *testing.T) {
+	targets := make([]int, validator.DefaultMaxBatchSize)
+	for i := range targets {
+		targets[i] = i + 2
+	}
+
+	for _, size := range []int{0, -1} {
+		v := validator.New(validator.Limits{MaxBatchSize: size})
+
+		require.NoError(t, v.Batch(1, targets))
+		require.ErrorIs(t, v.Batch(1, append(slices.Clone(targets), 1000)), validator.ErrBatchTooLarge)
+	}
+}
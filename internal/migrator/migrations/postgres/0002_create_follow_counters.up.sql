@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS follow_counters(
+    uuid INTEGER PRIMARY KEY,
+    followers BIGINT NOT NULL DEFAULT 0,
+    followees BIGINT NOT NULL DEFAULT 0
+);
+
+INSERT INTO follow_counters(uuid, followers, followees)
+    SELECT uuid, SUM(followers), SUM(followees) FROM (
+        SELECT followee AS uuid, COUNT(*) AS followers, 0 AS followees FROM followings GROUP BY followee
+        UNION ALL
+        SELECT follower AS uuid, 0 AS followers, COUNT(*) AS followees FROM followings GROUP BY follower
+    ) AS c
+    WHERE NOT EXISTS (SELECT 1 FROM follow_counters)
+    GROUP BY uuid;
@@ -0,0 +1 @@
+ALTER TABLE followings ADD COLUMN created_at TIMESTAMPTZ;
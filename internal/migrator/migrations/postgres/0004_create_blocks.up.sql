@@ -0,0 +1,10 @@
+CREATE TABLE blocks(
+    id BIGSERIAL PRIMARY KEY,
+    blocker INTEGER NOT NULL,
+    blocked INTEGER NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+
+    CONSTRAINT unique_blocks UNIQUE (blocker, blocked)
+);
+CREATE INDEX idx_blocker ON blocks(blocker, id);
+CREATE INDEX idx_blocked ON blocks(blocked);
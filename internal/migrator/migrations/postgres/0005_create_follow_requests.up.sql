@@ -0,0 +1,14 @@
+CREATE TABLE account_settings(
+    uuid INTEGER PRIMARY KEY,
+    private BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+CREATE TABLE follow_requests(
+    id BIGSERIAL PRIMARY KEY,
+    follower INTEGER NOT NULL,
+    followee INTEGER NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+
+    CONSTRAINT unique_follow_requests UNIQUE (follower, followee)
+);
+CREATE INDEX idx_follow_requests_followee ON follow_requests(followee, id);
@@ -0,0 +1,8 @@
+CREATE TABLE mutes(
+    id BIGSERIAL PRIMARY KEY,
+    muter INTEGER NOT NULL,
+    muted INTEGER NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+
+    CONSTRAINT unique_mutes UNIQUE (muter, muted)
+);
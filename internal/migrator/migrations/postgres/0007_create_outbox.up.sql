@@ -0,0 +1,9 @@
+CREATE TABLE outbox(
+    id BIGSERIAL PRIMARY KEY,
+    type TEXT NOT NULL,
+    follower INTEGER NOT NULL,
+    followee INTEGER NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    published_at TIMESTAMPTZ
+);
+CREATE INDEX idx_outbox_unpublished ON outbox(id) WHERE published_at IS NULL;
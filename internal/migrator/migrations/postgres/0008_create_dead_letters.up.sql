@@ -0,0 +1,9 @@
+CREATE TABLE dead_letters(
+    id BIGSERIAL PRIMARY KEY,
+    event_id BIGINT NOT NULL,
+    endpoint TEXT NOT NULL,
+    payload TEXT NOT NULL,
+    error TEXT NOT NULL,
+    attempts INTEGER NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
@@ -0,0 +1,8 @@
+CREATE TABLE idempotency_keys(
+    idempotency_key TEXT PRIMARY KEY,
+    fingerprint TEXT NOT NULL,
+    status BYTEA,
+    response BYTEA,
+    created_at TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX idx_idempotency_keys_created_at ON idempotency_keys(created_at);
@@ -0,0 +1,2 @@
+-- deleted self-followings can't be restored
+SELECT 1;
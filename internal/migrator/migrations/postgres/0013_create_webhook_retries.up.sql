@@ -0,0 +1,11 @@
+CREATE TABLE webhook_retries(
+    id BIGSERIAL PRIMARY KEY,
+    event_id BIGINT NOT NULL,
+    endpoint TEXT NOT NULL,
+    payload TEXT NOT NULL,
+    error TEXT NOT NULL,
+    attempts INTEGER NOT NULL,
+    next_attempt_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX idx_webhook_retries_next_attempt_at ON webhook_retries(next_attempt_at);
@@ -0,0 +1,8 @@
+ALTER TABLE outbox ADD COLUMN seq BIGINT;
+UPDATE outbox SET seq=id;
+CREATE UNIQUE INDEX idx_outbox_seq ON outbox(seq);
+CREATE INDEX idx_outbox_unsequenced ON outbox(id) WHERE seq IS NULL;
+DROP INDEX idx_outbox_follower;
+DROP INDEX idx_outbox_followee;
+CREATE INDEX idx_outbox_follower ON outbox(follower, seq);
+CREATE INDEX idx_outbox_followee ON outbox(followee, seq);
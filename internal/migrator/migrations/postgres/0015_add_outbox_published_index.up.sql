@@ -0,0 +1 @@
+CREATE INDEX idx_outbox_published ON outbox(published_at) WHERE published_at IS NOT NULL;
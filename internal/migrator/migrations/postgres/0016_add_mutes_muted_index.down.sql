@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_mutes_muted;
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS followings(
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    follower INTEGER NOT NULL,
+    followee INTEGER NOT NULL,
+
+    CONSTRAINT unique_followings UNIQUE (follower, followee)
+);
+CREATE INDEX IF NOT EXISTS idx_follower ON followings(follower);
+CREATE INDEX IF NOT EXISTS idx_followee ON followings(followee);
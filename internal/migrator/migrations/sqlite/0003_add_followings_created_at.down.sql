@@ -0,0 +1 @@
+ALTER TABLE followings DROP COLUMN created_at;
@@ -0,0 +1,9 @@
+CREATE TABLE blocks(
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    blocker INTEGER NOT NULL,
+    blocked INTEGER NOT NULL,
+    created_at INTEGER NOT NULL,
+
+    CONSTRAINT unique_blocks UNIQUE (blocker, blocked)
+);
+CREATE INDEX idx_blocked ON blocks(blocked);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS follow_requests;
+DROP TABLE IF EXISTS account_settings;
@@ -0,0 +1,8 @@
+CREATE TABLE mutes(
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    muter INTEGER NOT NULL,
+    muted INTEGER NOT NULL,
+    created_at INTEGER NOT NULL,
+
+    CONSTRAINT unique_mutes UNIQUE (muter, muted)
+);
@@ -0,0 +1,9 @@
+CREATE TABLE outbox(
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    type TEXT NOT NULL,
+    follower INTEGER NOT NULL,
+    followee INTEGER NOT NULL,
+    created_at INTEGER NOT NULL,
+    published_at INTEGER
+);
+CREATE INDEX idx_outbox_unpublished ON outbox(id) WHERE published_at IS NULL;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_outbox_followee;
+DROP INDEX IF EXISTS idx_outbox_follower;
@@ -0,0 +1,2 @@
+CREATE INDEX idx_outbox_follower ON outbox(follower, id);
+CREATE INDEX idx_outbox_followee ON outbox(followee, id);
@@ -0,0 +1,6 @@
+UPDATE follow_counters SET followers = followers - 1, followees = followees - 1
+    WHERE uuid IN (SELECT follower FROM followings WHERE follower = followee);
+DELETE FROM followings WHERE follower = followee;
+DELETE FROM follow_requests WHERE follower = followee;
+DELETE FROM blocks WHERE blocker = blocked;
+DELETE FROM mutes WHERE muter = muted;
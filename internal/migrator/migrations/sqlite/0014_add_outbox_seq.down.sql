@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_outbox_followee;
+DROP INDEX IF EXISTS idx_outbox_follower;
+DROP INDEX IF EXISTS idx_outbox_unsequenced;
+DROP INDEX IF EXISTS idx_outbox_seq;
+ALTER TABLE outbox DROP COLUMN seq;
+CREATE INDEX idx_outbox_follower ON outbox(follower, id);
+CREATE INDEX idx_outbox_followee ON outbox(followee, id);
@@ -0,0 +1 @@
+CREATE INDEX idx_mutes_muted ON mutes(muted);
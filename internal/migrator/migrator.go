@@ -0,0 +1,333 @@
+package migrator
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	_ "github.com/mattn/go-sqlite3"
+	"io/fs"
+	"log/slog"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+//go:embed migrations
+var migrationsFS embed.FS
+
+var ErrUnknownDriver = errors.New("unknown storage driver")
+
+// lockKey is the key of postgres advisory lock held while migrating
+const lockKey = 716_203_455
+
+// dialect describes differences between supported databases
+type dialect struct {
+	sqlDriver string
+	dir       string
+	bind      string
+	// params are appended to the url of the database
+	params string
+	// lock is executed first in every transaction of migrating to exclude concurrent migrators
+	lock string
+}
+
+var dialects = map[string]dialect{
+	// transactions of sqlite lock the whole database when they begin
+	"sqlite": {
+		sqlDriver: "sqlite3",
+		dir:       "migrations/sqlite",
+		bind:      "?",
+		params:    "_txlock=exclusive&_busy_timeout=30000",
+	},
+	"postgres": {
+		sqlDriver: "pgx",
+		dir:       "migrations/postgres",
+		bind:      "$1",
+		lock:      "SELECT pg_advisory_xact_lock(" + strconv.Itoa(lockKey) + ")",
+	},
+}
+
+type migration struct {
+	version int
+	name    string
+	up      string
+	down    string
+}
+
+type Migrator struct {
+	log        *slog.Logger
+	db         *sql.DB
+	dialect    dialect
+	migrations []migration
+}
+
+// New returns new migrator for the database of the storage driver available by url
+func New(log *slog.Logger, driver, url string) (*Migrator, error) {
+	const op = "migrator.New"
+
+	d, ok := dialects[driver]
+	if !ok {
+		return nil, fmt.Errorf("%s: %w: %s", op, ErrUnknownDriver, driver)
+	}
+
+	migrations, err := load(d.dir)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	db, err := sql.Open(d.sqlDriver, withParams(url, d.params))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	m := &Migrator{
+		log:        log,
+		db:         db,
+		dialect:    d,
+		migrations: migrations,
+	}
+
+	err = m.locked(context.Background(), func(tx *sql.Tx) error {
+		_, err := tx.Exec(
+			`CREATE TABLE IF NOT EXISTS schema_version(
+				version INTEGER PRIMARY KEY,
+				applied_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+			)`,
+		)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return m, nil
+}
+
+// Close closes the database connection of the migrator
+func (m *Migrator) Close() error {
+	return m.db.Close()
+}
+
+// Version returns version of the last applied migration. Zero means no migration is applied
+func (m *Migrator) Version(ctx context.Context) (int, error) {
+	const op = "migrator.Version"
+
+	version, err := currentVersion(ctx, m.db)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return version, nil
+}
+
+// Up applies all migrations newer than the current version. Returns number of applied migrations.
+// Migrations are applied in one transaction holding the migration lock, so replicas started
+// together apply every migration once. If any migration fails, none of them is applied
+func (m *Migrator) Up(ctx context.Context) (int, error) {
+	const op = "migrator.Up"
+	log := m.log.With(slog.String("op", op))
+
+	var applied []migration
+	err := m.locked(ctx, func(tx *sql.Tx) error {
+		current, err := currentVersion(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		for _, mg := range m.migrations {
+			if mg.version <= current {
+				continue
+			}
+
+			err = apply(
+				ctx, tx, mg.up,
+				`INSERT INTO schema_version(version) VALUES(`+m.dialect.bind+`)`, mg.version,
+			)
+			if err != nil {
+				return fmt.Errorf("migration %d_%s: %w", mg.version, mg.name, err)
+			}
+
+			applied = append(applied, mg)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, mg := range applied {
+		log.Info("applied migration", slog.Int("version", mg.version), slog.String("name", mg.name))
+	}
+
+	return len(applied), nil
+}
+
+// Down reverts at most 'steps' last applied migrations. Returns number of reverted migrations.
+// Migrations are reverted in one transaction holding the migration lock. If any migration fails,
+// none of them is reverted
+func (m *Migrator) Down(ctx context.Context, steps int) (int, error) {
+	const op = "migrator.Down"
+	log := m.log.With(slog.String("op", op))
+
+	var reverted []migration
+	err := m.locked(ctx, func(tx *sql.Tx) error {
+		current, err := currentVersion(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		for i := len(m.migrations) - 1; i >= 0 && len(reverted) < steps; i-- {
+			mg := m.migrations[i]
+			if mg.version > current {
+				continue
+			}
+
+			err = apply(
+				ctx, tx, mg.down,
+				`DELETE FROM schema_version WHERE version=`+m.dialect.bind, mg.version,
+			)
+			if err != nil {
+				return fmt.Errorf("migration %d_%s: %w", mg.version, mg.name, err)
+			}
+
+			reverted = append(reverted, mg)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, mg := range reverted {
+		log.Info("reverted migration", slog.Int("version", mg.version), slog.String("name", mg.name))
+	}
+
+	return len(reverted), nil
+}
+
+// locked executes fn in the transaction holding the migration lock. Concurrent migrators
+// wait for the lock, so they see the schema version updated by the holder
+func (m *Migrator) locked(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if m.dialect.lock != "" {
+		if _, err = tx.ExecContext(ctx, m.dialect.lock); err != nil {
+			return err
+		}
+	}
+
+	if err = fn(tx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// apply executes migration script and updates schema version
+func apply(ctx context.Context, tx *sql.Tx, script, versionQuery string, version int) error {
+	if _, err := tx.ExecContext(ctx, script); err != nil {
+		return err
+	}
+
+	_, err := tx.ExecContext(ctx, versionQuery, version)
+	return err
+}
+
+// querier executes queries either in the transaction or out of it
+type querier interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// currentVersion returns version of the last applied migration
+func currentVersion(ctx context.Context, q querier) (int, error) {
+	var version int
+	err := q.QueryRowContext(ctx, `SELECT COALESCE(MAX(version), 0) FROM schema_version`).Scan(&version)
+
+	return version, err
+}
+
+// withParams appends params to the query of the url
+func withParams(url, params string) string {
+	if params == "" {
+		return url
+	}
+	if strings.Contains(url, "?") {
+		return url + "&" + params
+	}
+
+	return url + "?" + params
+}
+
+// load reads migrations from the directory. Files are named as '<version>_<name>.<up|down>.sql'.
+// Migrations are returned in order of versions
+func load(dir string) ([]migration, error) {
+	entries, err := fs.ReadDir(migrationsFS, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int]*migration)
+	for _, e := range entries {
+		name := e.Name()
+
+		var direction string
+		switch {
+		case strings.HasSuffix(name, ".up.sql"):
+			direction = "up"
+		case strings.HasSuffix(name, ".down.sql"):
+			direction = "down"
+		default:
+			continue
+		}
+
+		prefix, rest, ok := strings.Cut(name, "_")
+		if !ok {
+			return nil, fmt.Errorf("invalid migration file name: %s", name)
+		}
+		version, err := strconv.Atoi(prefix)
+		if err != nil {
+			return nil, fmt.Errorf("invalid migration version: %s", name)
+		}
+
+		script, err := fs.ReadFile(migrationsFS, path.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+
+		mg, ok := byVersion[version]
+		if !ok {
+			mg = &migration{
+				version: version,
+				name:    strings.TrimSuffix(rest, "."+direction+".sql"),
+			}
+			byVersion[version] = mg
+		}
+
+		if direction == "up" {
+			mg.up = string(script)
+		} else {
+			mg.down = string(script)
+		}
+	}
+
+	res := make([]migration, 0, len(byVersion))
+	for _, mg := range byVersion {
+		res = append(res, *mg)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].version < res[j].version
+	})
+
+	return res, nil
+}
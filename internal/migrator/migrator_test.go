@@ -0,0 +1,163 @@
+package migrator_test
+
+import (
+	"context"
+	"database/sql"
+	"github.com/IlianBuh/Follow_Service/internal/migrator"
+	"github.com/stretchr/testify/require"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// versions returns versions of the migration files in the directory
+func versions(t *testing.T, dir string) []int {
+	t.Helper()
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+
+	var up, down []int
+	for _, e := range entries {
+		prefix, _, ok := strings.Cut(e.Name(), "_")
+		require.True(t, ok, e.Name())
+		version, err := strconv.Atoi(prefix)
+		require.NoError(t, err, e.Name())
+
+		switch {
+		case strings.HasSuffix(e.Name(), ".up.sql"):
+			up = append(up, version)
+		case strings.HasSuffix(e.Name(), ".down.sql"):
+			down = append(down, version)
+		}
+	}
+
+	// every migration can be reverted
+	require.Equal(t, up, down)
+	return up
+}
+
+func newMigrator(t *testing.T, path string) *migrator.Migrator {
+	t.Helper()
+
+	m, err := migrator.New(slog.New(slog.NewTextHandler(io.Discard, nil)), "sqlite", path)
+	require.NoError(t, err)
+	t.Cleanup(func() { m.Close() })
+
+	return m
+}
+
+// applied returns versions recorded in schema_version
+func applied(t *testing.T, path string) []int {
+	t.Helper()
+
+	db, err := sql.Open("sqlite3", path)
+	require.NoError(t, err)
+	defer db.Close()
+
+	rows, err := db.Query(`SELECT version FROM schema_version ORDER BY version`)
+	require.NoError(t, err)
+	defer rows.Close()
+
+	var res []int
+	for rows.Next() {
+		var version int
+		require.NoError(t, rows.Scan(&version))
+		res = append(res, version)
+	}
+	require.NoError(t, rows.Err())
+
+	return res
+}
+
+func TestUpDown(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "storage.db")
+	all := versions(t, filepath.Join("migrations", "sqlite"))
+	m := newMigrator(t, path)
+
+	version, err := m.Version(ctx)
+	require.NoError(t, err)
+	require.Zero(t, version)
+
+	n, err := m.Up(ctx)
+	require.NoError(t, err)
+	require.Equal(t, len(all), n)
+	require.Equal(t, all, applied(t, path))
+
+	version, err = m.Version(ctx)
+	require.NoError(t, err)
+	require.Equal(t, all[len(all)-1], version)
+
+	// nothing is left to apply
+	n, err = m.Up(ctx)
+	require.NoError(t, err)
+	require.Zero(t, n)
+
+	n, err = m.Down(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+	require.Equal(t, all[:len(all)-2], applied(t, path))
+
+	version, err = m.Version(ctx)
+	require.NoError(t, err)
+	require.Equal(t, all[len(all)-3], version)
+
+	// reverted migrations are applied again
+	n, err = m.Up(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+
+	n, err = m.Down(ctx, len(all)+1)
+	require.NoError(t, err)
+	require.Equal(t, len(all), n)
+	require.Empty(t, applied(t, path))
+
+	version, err = m.Version(ctx)
+	require.NoError(t, err)
+	require.Zero(t, version)
+}
+
+func TestConcurrentUp(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "storage.db")
+	all := versions(t, filepath.Join("migrations", "sqlite"))
+	first, second := newMigrator(t, path), newMigrator(t, path)
+
+	var (
+		wg     sync.WaitGroup
+		counts [2]int
+		errs   [2]error
+	)
+	for i, m := range []*migrator.Migrator{first, second} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			counts[i], errs[i] = m.Up(ctx)
+		}()
+	}
+	wg.Wait()
+
+	require.NoError(t, errs[0])
+	require.NoError(t, errs[1])
+
+	// one of the migrators applies everything, the other one finds the schema up to date
+	slices.Sort(counts[:])
+	require.Equal(t, [2]int{0, len(all)}, counts)
+	require.Equal(t, all, applied(t, path))
+
+	version, err := second.Version(ctx)
+	require.NoError(t, err)
+	require.Equal(t, all[len(all)-1], version)
+}
+
+func TestUnknownDriver(t *testing.T) {
+	_, err := migrator.New(slog.New(slog.NewTextHandler(io.Discard, nil)), "mysql", "")
+	require.ErrorIs(t, err, migrator.ErrUnknownDriver)
+}
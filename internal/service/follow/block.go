@@ -0,0 +1,121 @@
+package follow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/IlianBuh/Follow_Service/internal/lib/logger/sl"
+	"github.com/IlianBuh/Follow_Service/internal/storage"
+	"log/slog"
+)
+
+// Block blocks user target for user src. Followings between the users are removed
+// in both directions and can't be created until the block is removed
+func (f *Follow) Block(
+	ctx context.Context,
+	src, target int,
+) error {
+	const op = "follow.Block"
+	log := f.log.With(slog.String("op", op))
+	log.Info(
+		"starting to block",
+		slog.Int("src", src),
+		slog.Int("target", target),
+	)
+
+	if err := f.vld.Pair(src, target); err != nil {
+		log.Warn("invalid users", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err := f.checkUsers(ctx, src, target)
+	if err != nil {
+		if errors.Is(err, ErrInvalidUUIDs) {
+			log.Warn("some user does not exist", sl.Err(err))
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		log.Error("failed to check users' existing", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = f.blckr.Block(ctx, src, target)
+	if err != nil {
+		if errors.Is(err, storage.ErrBlocking) {
+			log.Warn("user already blocked")
+			return fmt.Errorf("%s: %w", op, usersError(ErrBlocking, src, target))
+		}
+
+		log.Error("failed to block user", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("successfully blocked user")
+	return nil
+}
+
+// Unblock removes block of user target for user src
+func (f *Follow) Unblock(
+	ctx context.Context,
+	src, target int,
+) error {
+	const op = "follow.Unblock"
+	log := f.log.With(slog.String("op", op))
+	log.Info(
+		"starting to unblock",
+		slog.Int("src", src),
+		slog.Int("target", target),
+	)
+
+	if err := f.vld.Pair(src, target); err != nil {
+		log.Warn("invalid users", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err := f.blckr.Unblock(ctx, src, target)
+	if err != nil {
+		if errors.Is(err, storage.ErrNoBlocking) {
+			log.Warn("user has not blocked")
+			return fmt.Errorf("%s: %w", op, usersError(ErrNoBlocking, src, target))
+		}
+
+		log.Error("failed to unblock user", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("successfully unblocked user")
+	return nil
+}
+
+// ListBlocked returns one page of users blocked by the user with the uuid and
+// the token of the next page. The token is empty if there are no more pages
+func (f *Follow) ListBlocked(
+	ctx context.Context,
+	uuid int,
+	pageSize int,
+	pageToken string,
+) ([]int, string, error) {
+	const op = "follow.ListBlocked"
+	log := f.log.With(slog.String("op", op))
+	log.Info("starting to list blocked users", slog.Int("uuid", uuid))
+
+	if err := f.vld.UUIDs(uuid); err != nil {
+		log.Warn("invalid uuid", sl.Err(err))
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	after, limit, err := pageParams(pageSize, pageToken)
+	if err != nil {
+		log.Warn("invalid page token", sl.Err(err))
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	blocked, next, err := f.blckr.ListBlocked(ctx, uuid, after, limit)
+	if err != nil {
+		log.Error("failed to list blocked users", sl.Err(err))
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("successfully listed blocked users")
+	return blocked, nextPageToken(next), nil
+}
@@ -0,0 +1,133 @@
+package follow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/IlianBuh/Follow_Service/internal/domain/models"
+	"github.com/IlianBuh/Follow_Service/internal/lib/logger/sl"
+	"github.com/IlianBuh/Follow_Service/internal/storage"
+	"log/slog"
+)
+
+// BulkFollow follows user src on every target and returns the result for every target in order
+// of targets. All users are checked by one request and all followings are created in one
+// transaction. Targets which do not exist get BulkStatusInvalidUser. Every created following
+// and follow request is charged to the follows rate limit and all existing targets are counted
+// to the followees limit
+func (f *Follow) BulkFollow(
+	ctx context.Context,
+	src int,
+	targets []int,
+) ([]models.BulkResult, error) {
+	const op = "follow.BulkFollow"
+	log := f.log.With(slog.String("op", op))
+	log.Info(
+		"starting to follow in bulk",
+		slog.Int("src", src),
+		slog.Int("targets", len(targets)),
+	)
+
+	if err := f.vld.Batch(src, targets); err != nil {
+		log.Warn("invalid users", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if len(targets) == 0 {
+		return []models.BulkResult{}, nil
+	}
+
+	existing, err := f.usrChkr.ExistingUsers(ctx, append([]int{src}, targets...))
+	if err != nil {
+		log.Error("failed to check users' existing", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w: %w", op, ErrUserInfoUnavailable, err)
+	}
+
+	exist := make(map[int]bool, len(existing))
+	for _, v := range existing {
+		exist[v] = true
+	}
+	if !exist[src] {
+		log.Warn("source user does not exist", slog.Int("src", src))
+		return nil, fmt.Errorf("%s: %w", op, usersError(ErrInvalidUUIDs, src))
+	}
+
+	valid := make([]int, 0, len(targets))
+	for _, v := range targets {
+		if exist[v] {
+			valid = append(valid, v)
+		}
+	}
+
+	// existing targets are charged before following, the rejected ones are refunded after it
+	if err = f.rtLmtr.AllowN(ctx, followRateKey(src), len(valid)); err != nil {
+		log.Warn("follows rate limit is exceeded", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	followed, err := f.blkFlw.BulkFollow(ctx, src, valid, f.flwLmt)
+	if err != nil {
+		f.refundFollows(ctx, log, src, len(valid))
+
+		if errors.Is(err, storage.ErrFolloweesLimit) {
+			log.Warn("followees limit is reached")
+			return nil, fmt.Errorf("%s: %w", op, usersError(ErrFolloweesLimit, src))
+		}
+
+		log.Error("failed to follow users", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rejected := 0
+	for _, v := range followed {
+		if v.Status != models.BulkStatusFollowed && v.Status != models.BulkStatusRequested {
+			rejected++
+		}
+	}
+	f.refundFollows(ctx, log, src, rejected)
+
+	res := make([]models.BulkResult, len(targets))
+	for i, v := range targets {
+		if !exist[v] {
+			res[i] = models.BulkResult{UUID: v, Status: models.BulkStatusInvalidUser}
+			continue
+		}
+
+		res[i], followed = followed[0], followed[1:]
+	}
+
+	log.Info("successfully followed users in bulk")
+	return res, nil
+}
+
+// BulkUnfollow unfollows user src from every target and returns the result for every target
+// in order of targets. All followings are removed in one transaction
+func (f *Follow) BulkUnfollow(
+	ctx context.Context,
+	src int,
+	targets []int,
+) ([]models.BulkResult, error) {
+	const op = "follow.BulkUnfollow"
+	log := f.log.With(slog.String("op", op))
+	log.Info(
+		"starting to unfollow in bulk",
+		slog.Int("src", src),
+		slog.Int("targets", len(targets)),
+	)
+
+	if err := f.vld.Batch(src, targets); err != nil {
+		log.Warn("invalid users", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if len(targets) == 0 {
+		return []models.BulkResult{}, nil
+	}
+
+	res, err := f.blkFlw.BulkUnfollow(ctx, src, targets)
+	if err != nil {
+		log.Error("failed to unfollow users", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("successfully unfollowed users in bulk")
+	return res, nil
+}
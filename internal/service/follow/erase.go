@@ -0,0 +1,39 @@
+package follow
+
+import (
+	"context"
+	"fmt"
+	"github.com/IlianBuh/Follow_Service/internal/domain/models"
+	"github.com/IlianBuh/Follow_Service/internal/lib/logger/sl"
+	"log/slog"
+)
+
+// DeleteUserGraph removes all followings, follow requests, blocks and mutes in which
+// the user with the uuid takes part and returns numbers of removed entities. It is used
+// when the user account is deleted
+func (f *Follow) DeleteUserGraph(
+	ctx context.Context,
+	uuid int,
+) (models.DeletedGraph, error) {
+	const op = "follow.DeleteUserGraph"
+	log := f.log.With(slog.String("op", op))
+	log.Info("starting to delete user graph", slog.Int("uuid", uuid))
+
+	if err := f.vld.UUIDs(uuid); err != nil {
+		log.Warn("invalid uuid", sl.Err(err))
+		return models.DeletedGraph{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	deleted, err := f.ers.DeleteUserGraph(ctx, uuid)
+	if err != nil {
+		log.Error("failed to delete user graph", sl.Err(err))
+		return models.DeletedGraph{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info(
+		"successfully deleted user graph",
+		slog.Int("followers", deleted.Followers),
+		slog.Int("followees", deleted.Followees),
+	)
+	return deleted, nil
+}
@@ -1,9 +1,41 @@
 package follow
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
-	ErrFollowing    = errors.New("user is already following")
-	ErrNoFollowing  = errors.New("user has not followed")
-	ErrInvalidUUIDs = errors.New("some user does not exist")
+	ErrFollowing           = errors.New("user is already following")
+	ErrNoFollowing         = errors.New("user has not followed")
+	ErrInvalidUUIDs        = errors.New("some user does not exist")
+	ErrInvalidPageToken    = errors.New("invalid page token")
+	ErrBlocking            = errors.New("user is already blocked")
+	ErrNoBlocking          = errors.New("user has not blocked")
+	ErrBlocked             = errors.New("following is blocked")
+	ErrRequested           = errors.New("follow request already exists")
+	ErrNoRequest           = errors.New("follow request does not exist")
+	ErrMuting              = errors.New("user is already muted")
+	ErrNoMuting            = errors.New("user has not muted")
+	ErrUserInfoUnavailable = errors.New("user info service is unavailable")
+	ErrFolloweesLimit      = errors.New("user follows too many users")
 )
+
+// UsersError is the error caused by particular users. It unwraps to Err
+type UsersError struct {
+	Err   error
+	UUIDs []int
+}
+
+func (e *UsersError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Err, e.UUIDs)
+}
+
+func (e *UsersError) Unwrap() error {
+	return e.Err
+}
+
+// usersError returns UsersError caused by the users with uuids
+func usersError(err error, uuids ...int) error {
+	return &UsersError{Err: err, UUIDs: uuids}
+}
@@ -4,54 +4,176 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"github.com/IlianBuh/Follow_Service/internal/domain/models"
+	"github.com/IlianBuh/Follow_Service/internal/lib/cursor"
 	"github.com/IlianBuh/Follow_Service/internal/lib/logger/sl"
 	"github.com/IlianBuh/Follow_Service/internal/storage"
 	"log/slog"
+	"slices"
+	"strconv"
 )
 
 type Follower interface {
-	Follow(context.Context, int, int) error
+	Follow(ctx context.Context, src, target, maxFollowees int) (models.FollowState, error)
 }
 type Unfollower interface {
 	Unfollow(context.Context, int, int) error
 }
 type FollowingsProvider interface {
-	ListFollowers(context.Context, int) ([]int, error)
-	ListFollowees(context.Context, int) ([]int, error)
+	ListFollowers(ctx context.Context, uuid, after, limit int, newestFirst bool) ([]models.Following, int, error)
+	ListFollowees(
+		ctx context.Context,
+		uuid, after, limit int,
+		newestFirst, excludeMuted bool,
+	) ([]models.Following, int, error)
+}
+type FollowingsStreamer interface {
+	StreamFollowers(ctx context.Context, uuid, size int, send func([]int) error) error
+	StreamFollowees(ctx context.Context, uuid, size int, send func([]int) error) error
+}
+type CommonFollowersProvider interface {
+	CommonFollowers(ctx context.Context, viewer, target, limit int) ([]int, int, error)
+}
+type CountersProvider interface {
+	CountFollowers(ctx context.Context, uuid int) (int, error)
+	CountFollowees(ctx context.Context, uuid int) (int, error)
+}
+type RelationshipsProvider interface {
+	Relationships(ctx context.Context, viewer int, targets []int) ([]models.Relationship, error)
+}
+type Blocker interface {
+	Block(ctx context.Context, src, target int) error
+	Unblock(ctx context.Context, src, target int) error
+	ListBlocked(ctx context.Context, uuid, after, limit int) ([]int, int, error)
+}
+type PrivacyManager interface {
+	SetPrivate(ctx context.Context, uuid int, private bool, maxFollowees int) error
+	IsPrivate(ctx context.Context, uuid int) (bool, error)
+}
+type RequestsManager interface {
+	ListFollowRequests(ctx context.Context, uuid, after, limit int) ([]models.Following, int, error)
+	ApproveFollowRequest(ctx context.Context, uuid, requester, maxFollowees int) error
+	RejectFollowRequest(ctx context.Context, uuid, requester int) error
+}
+type MutualsProvider interface {
+	ListMutuals(ctx context.Context, uuid, after, limit int) ([]models.Following, int, error)
+	CountMutuals(ctx context.Context, uuid int) (int, error)
+}
+type Muter interface {
+	Mute(ctx context.Context, src, target int) error
+	Unmute(ctx context.Context, src, target int) error
+}
+type BulkFollower interface {
+	BulkFollow(ctx context.Context, src int, targets []int, maxFollowees int) ([]models.BulkResult, error)
+	BulkUnfollow(ctx context.Context, src int, targets []int) ([]models.BulkResult, error)
+}
+type GraphEraser interface {
+	DeleteUserGraph(ctx context.Context, uuid int) (models.DeletedGraph, error)
 }
 type UsersChecker interface {
-	CheckUsers(ctx context.Context, uuids []int) (bool, error)
+	ExistingUsers(ctx context.Context, uuids []int) ([]int, error)
 }
+type Validator interface {
+	UUIDs(uuids ...int) error
+	Pair(src, target int) error
+	Batch(src int, targets []int) error
+	Lookup(viewer int, targets []int) error
+}
+type RateLimiter interface {
+	Allow(ctx context.Context, key string) error
+	AllowN(ctx context.Context, key string, n int) error
+	Refund(ctx context.Context, key string, n int) error
+}
+
+const (
+	defaultPageSize = 100
+	maxPageSize     = 1000
+
+	defaultChunkSize = 500
+	maxChunkSize     = 5000
+
+	defaultCommonSample = 3
+	maxCommonSample     = 20
+
+	// followRateKeyPrefix prefixes the uuid of the user in the key of follows rate limit
+	followRateKeyPrefix = "follow:"
+)
+
 type Follow struct {
 	log     *slog.Logger
 	flw     Follower
 	unflw   Unfollower
+	blkFlw  BulkFollower
 	flwPrv  FollowingsProvider
+	flwStrm FollowingsStreamer
+	cmnPrv  CommonFollowersProvider
+	cntPrv  CountersProvider
+	relPrv  RelationshipsProvider
+	mutPrv  MutualsProvider
+	blckr   Blocker
+	prvMgr  PrivacyManager
+	reqMgr  RequestsManager
+	mtr     Muter
+	ers     GraphEraser
 	usrChkr UsersChecker
+	vld     Validator
+	rtLmtr  RateLimiter
+	// flwLmt is maximum number of followees of the user, zero means no limit
+	flwLmt int
 }
 
-// New returns new instance of service layer
+// New returns new instance of service layer. Follows of every user are limited by
+// rtLmtr and flwLmt, zero flwLmt means no limit
 func New(
 	log *slog.Logger,
 	flw Follower,
 	unflw Unfollower,
+	blkFlw BulkFollower,
 	flwPrv FollowingsProvider,
+	flwStrm FollowingsStreamer,
+	cmnPrv CommonFollowersProvider,
+	cntPrv CountersProvider,
+	relPrv RelationshipsProvider,
+	mutPrv MutualsProvider,
+	blckr Blocker,
+	prvMgr PrivacyManager,
+	reqMgr RequestsManager,
+	mtr Muter,
+	ers GraphEraser,
 	usrChkr UsersChecker,
+	vld Validator,
+	rtLmtr RateLimiter,
+	flwLmt int,
 ) *Follow {
 	return &Follow{
 		log:     log,
 		flw:     flw,
 		unflw:   unflw,
+		blkFlw:  blkFlw,
 		flwPrv:  flwPrv,
+		flwStrm: flwStrm,
+		cmnPrv:  cmnPrv,
+		cntPrv:  cntPrv,
+		relPrv:  relPrv,
+		mutPrv:  mutPrv,
+		blckr:   blckr,
+		prvMgr:  prvMgr,
+		reqMgr:  reqMgr,
+		mtr:     mtr,
+		ers:     ers,
 		usrChkr: usrChkr,
+		vld:     vld,
+		rtLmtr:  rtLmtr,
+		flwLmt:  flwLmt,
 	}
 }
 
-// Follow follows user src on target
+// Follow follows user src on target. If the target account is private,
+// the follow request is created and has to be approved by target
 func (f *Follow) Follow(
 	ctx context.Context,
 	src, target int,
-) error {
+) (models.FollowState, error) {
 	const op = "follow.Follow"
 	log := f.log.With(slog.String("op", op))
 	log.Info(
@@ -60,97 +182,409 @@ func (f *Follow) Follow(
 		slog.Int("target", target),
 	)
 
-	exist, err := f.usrChkr.CheckUsers(ctx, []int{src, target})
+	if err := f.vld.Pair(src, target); err != nil {
+		log.Warn("invalid users", sl.Err(err))
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	err := f.checkUsers(ctx, src, target)
 	if err != nil {
+		if errors.Is(err, ErrInvalidUUIDs) {
+			log.Warn("some user does not exist", sl.Err(err))
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+
 		log.Error("failed to check users' existing", sl.Err(err))
-		return fmt.Errorf("%s: %w", op, err)
+		return 0, fmt.Errorf("%s: %w", op, err)
 	}
-	if !exist {
-		log.Warn(
-			"some user does not exist",
-			slog.Int("src", src),
-			slog.Int("target", target),
-		)
-		return fmt.Errorf("%s: %w", op, ErrInvalidUUIDs)
+
+	if err = f.rtLmtr.Allow(ctx, followRateKey(src)); err != nil {
+		log.Warn("follows rate limit is exceeded", sl.Err(err))
+		return 0, fmt.Errorf("%s: %w", op, err)
 	}
 
-	err = f.flw.Follow(ctx, src, target)
+	state, err := f.flw.Follow(ctx, src, target, f.flwLmt)
 	if err != nil {
+		// rejected follows are not counted to the rate limit
+		f.refundFollows(ctx, log, src, 1)
+
+		if errors.Is(err, storage.ErrFolloweesLimit) {
+			log.Warn("followees limit is reached")
+			return 0, fmt.Errorf("%s: %w", op, usersError(ErrFolloweesLimit, src))
+		}
 		if errors.Is(err, storage.ErrFollowing) {
 			log.Warn("user already following")
-			return fmt.Errorf("%s: %w", op, ErrFollowing)
+			return 0, fmt.Errorf("%s: %w", op, usersError(ErrFollowing, src, target))
+		}
+		if errors.Is(err, storage.ErrBlocked) {
+			log.Warn("following is blocked")
+			return 0, fmt.Errorf("%s: %w", op, usersError(ErrBlocked, src, target))
+		}
+		if errors.Is(err, storage.ErrRequested) {
+			log.Warn("follow request already exists")
+			return 0, fmt.Errorf("%s: %w", op, usersError(ErrRequested, src, target))
 		}
 
 		log.Error("failed to follow user", sl.Err(err))
-		return fmt.Errorf("%s: %w", op, err)
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if state == models.FollowStateRequested {
+		log.Info("successfully requested to follow user")
+		return state, nil
 	}
 
 	log.Info("successfully followed user")
-	return nil
+	return state, nil
 }
 
-// Unfollow unfollows user src on target
+// Unfollow unfollows user src on target and reports whether the following existed.
+// Missing following is ErrNoFollowing if 'strict' is set, otherwise it is not an error
 func (f *Follow) Unfollow(
 	ctx context.Context,
 	src, target int,
-) error {
+	strict bool,
+) (bool, error) {
 	const op = "follow.Unfollow"
 	log := f.log.With(slog.String("op", op))
 	log.Info(
 		"starting to unfollow",
 		slog.Int("src", src),
 		slog.Int("target", target),
+		slog.Bool("strict", strict),
 	)
 
+	if err := f.vld.Pair(src, target); err != nil {
+		log.Warn("invalid users", sl.Err(err))
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
 	err := f.unflw.Unfollow(ctx, src, target)
 	if err != nil {
 		if errors.Is(err, storage.ErrNoFollowing) {
 			log.Warn("user has not followed")
-			return fmt.Errorf("%s: %w", op, ErrNoFollowing)
+			if !strict {
+				return false, nil
+			}
+
+			return false, fmt.Errorf("%s: %w", op, usersError(ErrNoFollowing, src, target))
 		}
 
 		log.Error("failed to unfollow user", sl.Err(err))
-		return fmt.Errorf("%s: %w", op, err)
+		return false, fmt.Errorf("%s: %w", op, err)
 	}
 
 	log.Info("successfully unfollowed user")
-	return nil
+	return true, nil
 }
 
-// ListFollowers returns all followers of the user with the uuid
+// ListFollowers returns one page of followers of the user with the uuid and
+// the token of the next page. The token is empty if there are no more pages.
+// Followers are ordered by the following time, from the newest if 'newestFirst' is set
 func (f *Follow) ListFollowers(
 	ctx context.Context,
 	uuid int,
-) ([]int, error) {
+	pageSize int,
+	pageToken string,
+	newestFirst bool,
+) ([]models.Following, string, error) {
 	const op = "follow.ListFollowers"
 	log := f.log.With(slog.String("op", op))
 	log.Info("starting to list followers", slog.Int("uuid", uuid))
 
-	followers, err := f.flwPrv.ListFollowers(ctx, uuid)
+	if err := f.vld.UUIDs(uuid); err != nil {
+		log.Warn("invalid uuid", sl.Err(err))
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	after, limit, err := pageParams(pageSize, pageToken)
+	if err != nil {
+		log.Warn("invalid page token", sl.Err(err))
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	followers, next, err := f.flwPrv.ListFollowers(ctx, uuid, after, limit, newestFirst)
 	if err != nil {
 		log.Error("failed to list followers", sl.Err(err))
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return nil, "", fmt.Errorf("%s: %w", op, err)
 	}
 
 	log.Info("successfully listed followers")
-	return followers, nil
+	return followers, nextPageToken(next), nil
 }
 
-// ListFollowees returns all followees of the user with the uuid
+// ListFollowees returns one page of followees of the user with the uuid and
+// the token of the next page. The token is empty if there are no more pages.
+// Followees are ordered by the following time, from the newest if 'newestFirst' is set.
+// Followees muted by the user are skipped if 'excludeMuted' is set
 func (f *Follow) ListFollowees(
 	ctx context.Context,
 	uuid int,
-) ([]int, error) {
+	pageSize int,
+	pageToken string,
+	newestFirst bool,
+	excludeMuted bool,
+) ([]models.Following, string, error) {
 	const op = "follow.ListFollowees"
 	log := f.log.With(slog.String("op", op))
 	log.Info("starting to list followees", slog.Int("uuid", uuid))
 
-	followees, err := f.flwPrv.ListFollowees(ctx, uuid)
+	if err := f.vld.UUIDs(uuid); err != nil {
+		log.Warn("invalid uuid", sl.Err(err))
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	after, limit, err := pageParams(pageSize, pageToken)
+	if err != nil {
+		log.Warn("invalid page token", sl.Err(err))
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	followees, next, err := f.flwPrv.ListFollowees(ctx, uuid, after, limit, newestFirst, excludeMuted)
 	if err != nil {
 		log.Error("failed to list followees", sl.Err(err))
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return nil, "", fmt.Errorf("%s: %w", op, err)
 	}
 
 	log.Info("successfully listed followees")
-	return followees, nil
+	return followees, nextPageToken(next), nil
+}
+
+// CommonFollowers returns the sample of followees of the viewer who also follow the target
+// and the total number of such users. The sample contains at most 'sampleSize' users followed
+// by the viewer most recently. Non-positive sample size is replaced with default one
+func (f *Follow) CommonFollowers(
+	ctx context.Context,
+	viewer, target int,
+	sampleSize int,
+) ([]int, int, error) {
+	const op = "follow.CommonFollowers"
+	log := f.log.With(slog.String("op", op))
+	log.Info(
+		"starting to get common followers",
+		slog.Int("viewer", viewer),
+		slog.Int("target", target),
+	)
+
+	if err := f.vld.UUIDs(viewer, target); err != nil {
+		log.Warn("invalid uuid", sl.Err(err))
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	switch {
+	case sampleSize <= 0:
+		sampleSize = defaultCommonSample
+	case sampleSize > maxCommonSample:
+		sampleSize = maxCommonSample
+	}
+
+	sample, count, err := f.cmnPrv.CommonFollowers(ctx, viewer, target, sampleSize)
+	if err != nil {
+		log.Error("failed to get common followers", sl.Err(err))
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("successfully got common followers")
+	return sample, count, nil
+}
+
+// StreamFollowers passes all followers of the user with the uuid to 'send' by chunks.
+// Chunk slice is reused between calls, so 'send' must not retain it
+func (f *Follow) StreamFollowers(
+	ctx context.Context,
+	uuid int,
+	chunkSize int,
+	send func([]int) error,
+) error {
+	const op = "follow.StreamFollowers"
+	log := f.log.With(slog.String("op", op))
+	log.Info("starting to stream followers", slog.Int("uuid", uuid))
+
+	if err := f.vld.UUIDs(uuid); err != nil {
+		log.Warn("invalid uuid", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err := f.flwStrm.StreamFollowers(ctx, uuid, chunkSizeOrDefault(chunkSize), send)
+	if err != nil {
+		log.Error("failed to stream followers", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("successfully streamed followers")
+	return nil
+}
+
+// StreamFollowees passes all followees of the user with the uuid to 'send' by chunks.
+// Chunk slice is reused between calls, so 'send' must not retain it
+func (f *Follow) StreamFollowees(
+	ctx context.Context,
+	uuid int,
+	chunkSize int,
+	send func([]int) error,
+) error {
+	const op = "follow.StreamFollowees"
+	log := f.log.With(slog.String("op", op))
+	log.Info("starting to stream followees", slog.Int("uuid", uuid))
+
+	if err := f.vld.UUIDs(uuid); err != nil {
+		log.Warn("invalid uuid", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err := f.flwStrm.StreamFollowees(ctx, uuid, chunkSizeOrDefault(chunkSize), send)
+	if err != nil {
+		log.Error("failed to stream followees", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("successfully streamed followees")
+	return nil
+}
+
+// CountFollowers returns number of followers of the user with the uuid
+func (f *Follow) CountFollowers(
+	ctx context.Context,
+	uuid int,
+) (int, error) {
+	const op = "follow.CountFollowers"
+	log := f.log.With(slog.String("op", op))
+	log.Info("starting to count followers", slog.Int("uuid", uuid))
+
+	if err := f.vld.UUIDs(uuid); err != nil {
+		log.Warn("invalid uuid", sl.Err(err))
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	count, err := f.cntPrv.CountFollowers(ctx, uuid)
+	if err != nil {
+		log.Error("failed to count followers", sl.Err(err))
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("successfully counted followers")
+	return count, nil
+}
+
+// CountFollowees returns number of followees of the user with the uuid
+func (f *Follow) CountFollowees(
+	ctx context.Context,
+	uuid int,
+) (int, error) {
+	const op = "follow.CountFollowees"
+	log := f.log.With(slog.String("op", op))
+	log.Info("starting to count followees", slog.Int("uuid", uuid))
+
+	if err := f.vld.UUIDs(uuid); err != nil {
+		log.Warn("invalid uuid", sl.Err(err))
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	count, err := f.cntPrv.CountFollowees(ctx, uuid)
+	if err != nil {
+		log.Error("failed to count followees", sl.Err(err))
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("successfully counted followees")
+	return count, nil
+}
+
+// Relationships returns relationship between the viewer and every target in order of targets
+func (f *Follow) Relationships(
+	ctx context.Context,
+	viewer int,
+	targets []int,
+) ([]models.Relationship, error) {
+	const op = "follow.Relationships"
+	log := f.log.With(slog.String("op", op))
+	log.Info(
+		"starting to get relationships",
+		slog.Int("viewer", viewer),
+		slog.Int("targets", len(targets)),
+	)
+
+	if err := f.vld.Lookup(viewer, targets); err != nil {
+		log.Warn("invalid users", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rels, err := f.relPrv.Relationships(ctx, viewer, targets)
+	if err != nil {
+		log.Error("failed to get relationships", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("successfully got relationships")
+	return rels, nil
+}
+
+// chunkSizeOrDefault replaces non-positive chunk size with default one and cuts too large to maximum
+func chunkSizeOrDefault(chunkSize int) int {
+	switch {
+	case chunkSize <= 0:
+		return defaultChunkSize
+	case chunkSize > maxChunkSize:
+		return maxChunkSize
+	}
+
+	return chunkSize
+}
+
+// pageParams converts page size and page token to the storage cursor and limit.
+// Non-positive page size is replaced with default one, too large is cut to maximum
+func pageParams(pageSize int, pageToken string) (int, int, error) {
+	after, err := cursor.Decode(pageToken)
+	if err != nil {
+		return 0, 0, ErrInvalidPageToken
+	}
+
+	switch {
+	case pageSize <= 0:
+		pageSize = defaultPageSize
+	case pageSize > maxPageSize:
+		pageSize = maxPageSize
+	}
+
+	return after, pageSize, nil
+}
+
+// followRateKey returns the key of the follows rate limit of the user
+func followRateKey(uuid int) string {
+	return followRateKeyPrefix + strconv.Itoa(uuid)
+}
+
+// refundFollows takes back 'n' follows of the user charged to the rate limit. Failed refund
+// only makes the limit stricter, so it is logged and ignored
+func (f *Follow) refundFollows(ctx context.Context, log *slog.Logger, uuid, n int) {
+	if err := f.rtLmtr.Refund(context.WithoutCancel(ctx), followRateKey(uuid), n); err != nil {
+		log.Warn("failed to refund follows", sl.Err(err))
+	}
+}
+
+// checkUsers returns UsersError with ErrInvalidUUIDs if some of the users do not exist.
+// ErrUserInfoUnavailable is returned if the users can't be checked
+func (f *Follow) checkUsers(ctx context.Context, uuids ...int) error {
+	existing, err := f.usrChkr.ExistingUsers(ctx, uuids)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrUserInfoUnavailable, err)
+	}
+
+	missing := slices.DeleteFunc(slices.Clone(uuids), func(v int) bool {
+		return slices.Contains(existing, v)
+	})
+	if len(missing) > 0 {
+		return usersError(ErrInvalidUUIDs, missing...)
+	}
+
+	return nil
+}
+
+// nextPageToken returns page token for the storage cursor. Zero cursor means there is no next page
+func nextPageToken(next int) string {
+	if next == 0 {
+		return ""
+	}
+
+	return cursor.Encode(next)
 }
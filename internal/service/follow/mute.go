@@ -0,0 +1,88 @@
+package follow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/IlianBuh/Follow_Service/internal/lib/logger/sl"
+	"github.com/IlianBuh/Follow_Service/internal/storage"
+	"log/slog"
+)
+
+// Mute mutes user target for user src. The following is kept, but target
+// can be excluded from the list of followees of src
+func (f *Follow) Mute(
+	ctx context.Context,
+	src, target int,
+) error {
+	const op = "follow.Mute"
+	log := f.log.With(slog.String("op", op))
+	log.Info(
+		"starting to mute",
+		slog.Int("src", src),
+		slog.Int("target", target),
+	)
+
+	if err := f.vld.Pair(src, target); err != nil {
+		log.Warn("invalid users", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err := f.checkUsers(ctx, src, target)
+	if err != nil {
+		if errors.Is(err, ErrInvalidUUIDs) {
+			log.Warn("some user does not exist", sl.Err(err))
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		log.Error("failed to check users' existing", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = f.mtr.Mute(ctx, src, target)
+	if err != nil {
+		if errors.Is(err, storage.ErrMuting) {
+			log.Warn("user already muted")
+			return fmt.Errorf("%s: %w", op, usersError(ErrMuting, src, target))
+		}
+
+		log.Error("failed to mute user", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("successfully muted user")
+	return nil
+}
+
+// Unmute removes mute of user target for user src
+func (f *Follow) Unmute(
+	ctx context.Context,
+	src, target int,
+) error {
+	const op = "follow.Unmute"
+	log := f.log.With(slog.String("op", op))
+	log.Info(
+		"starting to unmute",
+		slog.Int("src", src),
+		slog.Int("target", target),
+	)
+
+	if err := f.vld.Pair(src, target); err != nil {
+		log.Warn("invalid users", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err := f.mtr.Unmute(ctx, src, target)
+	if err != nil {
+		if errors.Is(err, storage.ErrNoMuting) {
+			log.Warn("user has not muted")
+			return fmt.Errorf("%s: %w", op, usersError(ErrNoMuting, src, target))
+		}
+
+		log.Error("failed to unmute user", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("successfully unmuted user")
+	return nil
+}
@@ -0,0 +1,66 @@
+package follow
+
+import (
+	"context"
+	"fmt"
+	"github.com/IlianBuh/Follow_Service/internal/domain/models"
+	"github.com/IlianBuh/Follow_Service/internal/lib/logger/sl"
+	"log/slog"
+)
+
+// ListMutuals returns one page of users who both follow and are followed by the user
+// with the uuid and the token of the next page. The token is empty if there are no more pages
+func (f *Follow) ListMutuals(
+	ctx context.Context,
+	uuid int,
+	pageSize int,
+	pageToken string,
+) ([]models.Following, string, error) {
+	const op = "follow.ListMutuals"
+	log := f.log.With(slog.String("op", op))
+	log.Info("starting to list mutuals", slog.Int("uuid", uuid))
+
+	if err := f.vld.UUIDs(uuid); err != nil {
+		log.Warn("invalid uuid", sl.Err(err))
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	after, limit, err := pageParams(pageSize, pageToken)
+	if err != nil {
+		log.Warn("invalid page token", sl.Err(err))
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	mutuals, next, err := f.mutPrv.ListMutuals(ctx, uuid, after, limit)
+	if err != nil {
+		log.Error("failed to list mutuals", sl.Err(err))
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("successfully listed mutuals")
+	return mutuals, nextPageToken(next), nil
+}
+
+// CountMutuals returns number of users who both follow and are followed by the user with the uuid
+func (f *Follow) CountMutuals(
+	ctx context.Context,
+	uuid int,
+) (int, error) {
+	const op = "follow.CountMutuals"
+	log := f.log.With(slog.String("op", op))
+	log.Info("starting to count mutuals", slog.Int("uuid", uuid))
+
+	if err := f.vld.UUIDs(uuid); err != nil {
+		log.Warn("invalid uuid", sl.Err(err))
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	count, err := f.mutPrv.CountMutuals(ctx, uuid)
+	if err != nil {
+		log.Error("failed to count mutuals", sl.Err(err))
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("successfully counted mutuals")
+	return count, nil
+}
@@ -0,0 +1,170 @@
+package follow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/IlianBuh/Follow_Service/internal/domain/models"
+	"github.com/IlianBuh/Follow_Service/internal/lib/logger/sl"
+	"github.com/IlianBuh/Follow_Service/internal/storage"
+	"log/slog"
+)
+
+// SetPrivacy makes the account with the uuid private or public. Follows to the private
+// account have to be approved by its owner. Pending follow requests are approved when
+// the account becomes public
+func (f *Follow) SetPrivacy(
+	ctx context.Context,
+	uuid int,
+	private bool,
+) error {
+	const op = "follow.SetPrivacy"
+	log := f.log.With(slog.String("op", op))
+	log.Info(
+		"starting to set privacy",
+		slog.Int("uuid", uuid),
+		slog.Bool("private", private),
+	)
+
+	if err := f.vld.UUIDs(uuid); err != nil {
+		log.Warn("invalid uuid", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err := f.prvMgr.SetPrivate(ctx, uuid, private, f.flwLmt)
+	if err != nil {
+		log.Error("failed to set privacy", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("successfully set privacy")
+	return nil
+}
+
+// IsPrivate reports whether the account with the uuid is private
+func (f *Follow) IsPrivate(
+	ctx context.Context,
+	uuid int,
+) (bool, error) {
+	const op = "follow.IsPrivate"
+	log := f.log.With(slog.String("op", op))
+	log.Info("starting to get privacy", slog.Int("uuid", uuid))
+
+	if err := f.vld.UUIDs(uuid); err != nil {
+		log.Warn("invalid uuid", sl.Err(err))
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	private, err := f.prvMgr.IsPrivate(ctx, uuid)
+	if err != nil {
+		log.Error("failed to get privacy", sl.Err(err))
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("successfully got privacy")
+	return private, nil
+}
+
+// ListFollowRequests returns one page of pending follow requests to the user with the uuid and
+// the token of the next page. The token is empty if there are no more pages
+func (f *Follow) ListFollowRequests(
+	ctx context.Context,
+	uuid int,
+	pageSize int,
+	pageToken string,
+) ([]models.Following, string, error) {
+	const op = "follow.ListFollowRequests"
+	log := f.log.With(slog.String("op", op))
+	log.Info("starting to list follow requests", slog.Int("uuid", uuid))
+
+	if err := f.vld.UUIDs(uuid); err != nil {
+		log.Warn("invalid uuid", sl.Err(err))
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	after, limit, err := pageParams(pageSize, pageToken)
+	if err != nil {
+		log.Warn("invalid page token", sl.Err(err))
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	requests, next, err := f.reqMgr.ListFollowRequests(ctx, uuid, after, limit)
+	if err != nil {
+		log.Error("failed to list follow requests", sl.Err(err))
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("successfully listed follow requests")
+	return requests, nextPageToken(next), nil
+}
+
+// ApproveFollowRequest approves the follow request of the requester to the user with the uuid.
+// The request is kept if the requester has reached the followees limit
+func (f *Follow) ApproveFollowRequest(
+	ctx context.Context,
+	uuid, requester int,
+) error {
+	const op = "follow.ApproveFollowRequest"
+	log := f.log.With(slog.String("op", op))
+	log.Info(
+		"starting to approve follow request",
+		slog.Int("uuid", uuid),
+		slog.Int("requester", requester),
+	)
+
+	if err := f.vld.Pair(requester, uuid); err != nil {
+		log.Warn("invalid users", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err := f.reqMgr.ApproveFollowRequest(ctx, uuid, requester, f.flwLmt)
+	if err != nil {
+		if errors.Is(err, storage.ErrFolloweesLimit) {
+			log.Warn("followees limit of the requester is reached")
+			return fmt.Errorf("%s: %w", op, usersError(ErrFolloweesLimit, requester))
+		}
+		if errors.Is(err, storage.ErrNoRequest) {
+			log.Warn("follow request does not exist")
+			return fmt.Errorf("%s: %w", op, usersError(ErrNoRequest, requester, uuid))
+		}
+
+		log.Error("failed to approve follow request", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("successfully approved follow request")
+	return nil
+}
+
+// RejectFollowRequest rejects the follow request of the requester to the user with the uuid
+func (f *Follow) RejectFollowRequest(
+	ctx context.Context,
+	uuid, requester int,
+) error {
+	const op = "follow.RejectFollowRequest"
+	log := f.log.With(slog.String("op", op))
+	log.Info(
+		"starting to reject follow request",
+		slog.Int("uuid", uuid),
+		slog.Int("requester", requester),
+	)
+
+	if err := f.vld.Pair(requester, uuid); err != nil {
+		log.Warn("invalid users", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err := f.reqMgr.RejectFollowRequest(ctx, uuid, requester)
+	if err != nil {
+		if errors.Is(err, storage.ErrNoRequest) {
+			log.Warn("follow request does not exist")
+			return fmt.Errorf("%s: %w", op, usersError(ErrNoRequest, requester, uuid))
+		}
+
+		log.Error("failed to reject follow request", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("successfully rejected follow request")
+	return nil
+}
@@ -0,0 +1,7 @@
+package graph
+
+import "errors"
+
+var (
+	ErrSearchLimit = errors.New("search limit is exceeded")
+)
@@ -0,0 +1,191 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/IlianBuh/Follow_Service/internal/lib/logger/sl"
+	"log/slog"
+	"slices"
+)
+
+type NeighboursProvider interface {
+	FolloweesOf(ctx context.Context, uuids []int, limit int) (map[int][]int, error)
+	FollowersOf(ctx context.Context, uuids []int, limit int) (map[int][]int, error)
+}
+type Validator interface {
+	UUIDs(uuids ...int) error
+}
+
+const (
+	maxDepth = 6
+
+	// maxVisited is number of users the search may visit before giving up.
+	// It also limits number of followings fetched by one lookup
+	maxVisited = 10000
+)
+
+// visit describes the user reached by the search
+type visit struct {
+	// prev is the user the visited one was reached from
+	prev int
+	// dist is number of followings between the visited user and the search root
+	dist int
+}
+
+type Graph struct {
+	log   *slog.Logger
+	nbPrv NeighboursProvider
+	vld   Validator
+}
+
+// New returns new instance of the follow graph service
+func New(
+	log *slog.Logger,
+	nbPrv NeighboursProvider,
+	vld Validator,
+) *Graph {
+	return &Graph{
+		log:   log,
+		nbPrv: nbPrv,
+		vld:   vld,
+	}
+}
+
+// FollowPath returns the shortest chain of users from src to dst where every user follows
+// the next one. The chain is empty if there is no chain of at most 'depth' followings.
+// Non-positive or too large depth is replaced with maximum one. The search goes from both ends
+// at once and fails with ErrSearchLimit if it visits too many users
+func (g *Graph) FollowPath(
+	ctx context.Context,
+	src, dst int,
+	depth int,
+) ([]int, error) {
+	const op = "graph.FollowPath"
+	log := g.log.With(slog.String("op", op))
+	log.Info(
+		"starting to find follow path",
+		slog.Int("src", src),
+		slog.Int("dst", dst),
+	)
+
+	if err := g.vld.UUIDs(src, dst); err != nil {
+		log.Warn("invalid uuid", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if src == dst {
+		return []int{src}, nil
+	}
+
+	if depth <= 0 || depth > maxDepth {
+		depth = maxDepth
+	}
+
+	fwd := map[int]visit{src: {prev: src}}
+	bwd := map[int]visit{dst: {prev: dst}}
+	fwdFront, bwdFront := []int{src}, []int{dst}
+
+	for i := 0; i < depth; i++ {
+		if len(fwdFront) == 0 || len(bwdFront) == 0 {
+			break
+		}
+
+		var (
+			meet  int
+			found bool
+			err   error
+		)
+		if len(fwdFront) <= len(bwdFront) {
+			fwdFront, meet, found, err = expand(ctx, fwdFront, fwd, bwd, g.nbPrv.FolloweesOf)
+		} else {
+			bwdFront, meet, found, err = expand(ctx, bwdFront, bwd, fwd, g.nbPrv.FollowersOf)
+		}
+		if err != nil {
+			if errors.Is(err, ErrSearchLimit) {
+				log.Warn("too many followings fetched", slog.Int("max", maxVisited))
+				return nil, fmt.Errorf("%s: %w", op, err)
+			}
+
+			log.Error("failed to expand search", sl.Err(err))
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		if found {
+			log.Info("successfully found follow path")
+			return buildPath(meet, fwd, bwd), nil
+		}
+
+		if len(fwd)+len(bwd) > maxVisited {
+			log.Warn("too many users visited", slog.Int("max", maxVisited))
+			return nil, fmt.Errorf("%s: %w", op, ErrSearchLimit)
+		}
+	}
+
+	log.Info("follow path is not found")
+	return []int{}, nil
+}
+
+// expand visits all neighbours of the frontier users returned by lookup and returns the next
+// frontier. If some neighbour was already visited from the other end, the one closest to the
+// other end is returned as the meeting user
+func expand(
+	ctx context.Context,
+	front []int,
+	own, other map[int]visit,
+	lookup func(ctx context.Context, uuids []int, limit int) (map[int][]int, error),
+) ([]int, int, bool, error) {
+	edges, err := lookup(ctx, front, maxVisited)
+	if err != nil {
+		return nil, 0, false, err
+	}
+
+	total := 0
+	for _, v := range edges {
+		total += len(v)
+	}
+	if total >= maxVisited {
+		return nil, 0, false, ErrSearchLimit
+	}
+
+	var (
+		next  []int
+		meet  int
+		found bool
+	)
+	for _, u := range front {
+		for _, v := range edges[u] {
+			if _, ok := own[v]; ok {
+				continue
+			}
+
+			own[v] = visit{prev: u, dist: own[u].dist + 1}
+			next = append(next, v)
+
+			if o, ok := other[v]; ok && (!found || o.dist < other[meet].dist) {
+				meet, found = v, true
+			}
+		}
+	}
+
+	return next, meet, found, nil
+}
+
+// buildPath joins chains from the meeting user to both search roots
+func buildPath(meet int, fwd, bwd map[int]visit) []int {
+	path := make([]int, 0, fwd[meet].dist+bwd[meet].dist+1)
+	for v := meet; ; v = fwd[v].prev {
+		path = append(path, v)
+		if fwd[v].dist == 0 {
+			break
+		}
+	}
+	slices.Reverse(path)
+
+	for v := meet; bwd[v].dist > 0; {
+		v = bwd[v].prev
+		path = append(path, v)
+	}
+
+	return path
+}
@@ -0,0 +1,8 @@
+package idempotency
+
+import "errors"
+
+var (
+	ErrInvalidKey = errors.New("invalid idempotency key")
+	ErrKeyReused  = errors.New("idempotency key is reused with another request")
+)
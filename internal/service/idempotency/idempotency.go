@@ -0,0 +1,114 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/IlianBuh/Follow_Service/internal/domain/models"
+	"github.com/IlianBuh/Follow_Service/internal/lib/logger/sl"
+	"github.com/IlianBuh/Follow_Service/internal/storage"
+	"log/slog"
+	"strconv"
+	"time"
+)
+
+type KeysStorage interface {
+	SaveIdempotencyKey(ctx context.Context, key models.IdempotencyKey, expiredBefore time.Time) error
+	IdempotencyKey(ctx context.Context, key string, expiredBefore time.Time) (models.IdempotencyKey, error)
+}
+
+const maxKeyLength = 128
+
+type Idempotency struct {
+	log     *slog.Logger
+	keysStr KeysStorage
+	ttl     time.Duration
+}
+
+// New returns new instance of the idempotency service. Outcomes of requests are kept for 'ttl'
+func New(
+	log *slog.Logger,
+	keysStr KeysStorage,
+	ttl time.Duration,
+) *Idempotency {
+	return &Idempotency{
+		log:     log,
+		keysStr: keysStr,
+		ttl:     ttl,
+	}
+}
+
+// Outcome returns the saved outcome of the request made by the caller with the key. Keys of
+// different callers never collide. The second value is false if there is no outcome yet.
+// ErrKeyReused is returned if the outcome belongs to the request with another fingerprint
+func (i *Idempotency) Outcome(
+	ctx context.Context,
+	caller int,
+	key, fingerprint string,
+) (models.IdempotencyKey, bool, error) {
+	const op = "idempotency.Outcome"
+	log := i.log.With(slog.String("op", op))
+
+	if len(key) > maxKeyLength {
+		log.Warn("idempotency key is too long", slog.Int("length", len(key)))
+		return models.IdempotencyKey{}, false, fmt.Errorf("%s: %w", op, ErrInvalidKey)
+	}
+
+	outcome, err := i.keysStr.IdempotencyKey(ctx, scopedKey(caller, key), time.Now().Add(-i.ttl))
+	if err != nil {
+		if errors.Is(err, storage.ErrIdempotencyKeyNotFound) {
+			return models.IdempotencyKey{}, false, nil
+		}
+
+		log.Error("failed to get outcome", sl.Err(err))
+		return models.IdempotencyKey{}, false, fmt.Errorf("%s: %w", op, err)
+	}
+	if outcome.Fingerprint != fingerprint {
+		log.Warn("idempotency key is reused", slog.Int("caller", caller), slog.String("key", key))
+		return models.IdempotencyKey{}, false, fmt.Errorf("%s: %w", op, ErrKeyReused)
+	}
+
+	log.Info("request is replayed", slog.Int("caller", caller), slog.String("key", key))
+	outcome.Key = key
+	return outcome, true, nil
+}
+
+// Save saves the outcome of the request made by the caller. If the outcome of the key was saved
+// concurrently, the saved one is returned and the caller must pass it instead of its own
+func (i *Idempotency) Save(
+	ctx context.Context,
+	caller int,
+	outcome models.IdempotencyKey,
+) (models.IdempotencyKey, error) {
+	const op = "idempotency.Save"
+	log := i.log.With(slog.String("op", op))
+
+	outcome.CreatedAt = time.Now()
+	scoped := outcome
+	scoped.Key = scopedKey(caller, outcome.Key)
+	err := i.keysStr.SaveIdempotencyKey(ctx, scoped, outcome.CreatedAt.Add(-i.ttl))
+	if err != nil {
+		if !errors.Is(err, storage.ErrIdempotencyKeyExists) {
+			log.Error("failed to save outcome", sl.Err(err))
+			return models.IdempotencyKey{}, fmt.Errorf("%s: %w", op, err)
+		}
+
+		saved, ok, err := i.Outcome(ctx, caller, outcome.Key, outcome.Fingerprint)
+		if err != nil {
+			return models.IdempotencyKey{}, fmt.Errorf("%s: %w", op, err)
+		}
+		if ok {
+			return saved, nil
+		}
+
+		// the saved outcome has expired just now, so the own one is passed
+		return outcome, nil
+	}
+
+	return outcome, nil
+}
+
+// scopedKey returns the key under which the outcome of the caller's request is stored
+func scopedKey(caller int, key string) string {
+	return strconv.Itoa(caller) + ":" + key
+}
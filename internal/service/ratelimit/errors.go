@@ -0,0 +1,23 @@
+package ratelimit
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var ErrLimitExceeded = errors.New("rate limit is exceeded")
+
+// LimitError is returned when the rate limit is exceeded. It unwraps to ErrLimitExceeded
+type LimitError struct {
+	// RetryAfter is the time after which the hit will be allowed
+	RetryAfter time.Duration
+}
+
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("%s: retry after %s", ErrLimitExceeded, e.RetryAfter)
+}
+
+func (e *LimitError) Unwrap() error {
+	return ErrLimitExceeded
+}
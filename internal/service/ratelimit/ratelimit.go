@@ -0,0 +1,95 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/IlianBuh/Follow_Service/internal/domain/models"
+	"github.com/IlianBuh/Follow_Service/internal/lib/logger/sl"
+	"github.com/IlianBuh/Follow_Service/internal/storage"
+	"log/slog"
+	"time"
+)
+
+type HitsStorage interface {
+	TakeHits(ctx context.Context, key string, at time.Time, n int, limits []models.RateLimit) (time.Time, error)
+	ReturnHits(ctx context.Context, key string, n int) error
+}
+
+type RateLimit struct {
+	log     *slog.Logger
+	hitsStr HitsStorage
+	limits  []models.RateLimit
+}
+
+// New returns new instance of the rate limiting service. Limits with zero count are disabled
+func New(
+	log *slog.Logger,
+	hitsStr HitsStorage,
+	limits ...models.RateLimit,
+) *RateLimit {
+	enabled := make([]models.RateLimit, 0, len(limits))
+	for _, limit := range limits {
+		if limit.Count > 0 {
+			enabled = append(enabled, limit)
+		}
+	}
+
+	return &RateLimit{
+		log:     log,
+		hitsStr: hitsStr,
+		limits:  enabled,
+	}
+}
+
+// Allow records the hit of the key. LimitError is returned if the hit exceeds any of the limits
+func (r *RateLimit) Allow(ctx context.Context, key string) error {
+	return r.AllowN(ctx, key, 1)
+}
+
+// AllowN records 'n' hits of the key at once, either all of them or none. LimitError is returned
+// if the hits exceed any of the limits. If there are more hits than any of the limits allows,
+// they are never allowed and ErrLimitExceeded is returned without the retry delay
+func (r *RateLimit) AllowN(ctx context.Context, key string, n int) error {
+	const op = "ratelimit.AllowN"
+	log := r.log.With(slog.String("op", op))
+
+	if len(r.limits) == 0 || n <= 0 {
+		return nil
+	}
+
+	now := time.Now()
+	retryAt, err := r.hitsStr.TakeHits(ctx, key, now, n, r.limits)
+	if err != nil {
+		if errors.Is(err, storage.ErrRateLimited) {
+			log.Warn("rate limit is exceeded", slog.String("key", key), slog.Int("hits", n))
+			if retryAt.IsZero() {
+				return fmt.Errorf("%s: %w", op, ErrLimitExceeded)
+			}
+
+			return fmt.Errorf("%s: %w", op, &LimitError{RetryAfter: retryAt.Sub(now)})
+		}
+
+		log.Error("failed to take hit", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// Refund takes back 'n' hits of the key recorded by Allow or AllowN, e.g. when the action
+// they were charged for was rejected
+func (r *RateLimit) Refund(ctx context.Context, key string, n int) error {
+	const op = "ratelimit.Refund"
+
+	if len(r.limits) == 0 || n <= 0 {
+		return nil
+	}
+
+	if err := r.hitsStr.ReturnHits(ctx, key, n); err != nil {
+		r.log.Error("failed to return hits", slog.String("op", op), sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
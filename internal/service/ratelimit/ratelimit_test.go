@@ -0,0 +1,75 @@
+package ratelimit_test
+
+import (
+	"context"
+	"errors"
+	"github.com/IlianBuh/Follow_Service/internal/domain/models"
+	"github.com/IlianBuh/Follow_Service/internal/service/ratelimit"
+	"github.com/IlianBuh/Follow_Service/internal/storage/memory"
+	"github.com/stretchr/testify/require"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+)
+
+func newRateLimit(limits ...models.RateLimit) *ratelimit.RateLimit {
+	return ratelimit.New(slog.New(slog.NewTextHandler(io.Discard, nil)), memory.New(), limits...)
+}
+
+func TestAllowRetryAfter(t *testing.T) {
+	ctx := context.Background()
+	rl := newRateLimit(models.RateLimit{Count: 2, Window: time.Hour})
+
+	require.NoError(t, rl.Allow(ctx, "key"))
+	require.NoError(t, rl.Allow(ctx, "key"))
+
+	err := rl.Allow(ctx, "key")
+	require.ErrorIs(t, err, ratelimit.ErrLimitExceeded)
+
+	var lmtErr *ratelimit.LimitError
+	require.ErrorAs(t, err, &lmtErr)
+	require.InDelta(t, time.Hour, lmtErr.RetryAfter, float64(time.Second))
+
+	require.NoError(t, rl.Allow(ctx, "other"))
+}
+
+func TestAllowN(t *testing.T) {
+	ctx := context.Background()
+	rl := newRateLimit(models.RateLimit{Count: 3, Window: time.Hour})
+
+	require.NoError(t, rl.AllowN(ctx, "key", 2))
+
+	// hits are taken all or none
+	var lmtErr *ratelimit.LimitError
+	require.ErrorAs(t, rl.AllowN(ctx, "key", 2), &lmtErr)
+	require.NoError(t, rl.Allow(ctx, "key"))
+
+	// more hits than the limit never succeed, so there is no retry delay
+	err := rl.AllowN(ctx, "other", 4)
+	require.ErrorIs(t, err, ratelimit.ErrLimitExceeded)
+	require.False(t, errors.As(err, &lmtErr))
+
+	require.NoError(t, rl.AllowN(ctx, "key", 0))
+}
+
+func TestRefund(t *testing.T) {
+	ctx := context.Background()
+	rl := newRateLimit(models.RateLimit{Count: 2, Window: time.Hour})
+
+	require.NoError(t, rl.AllowN(ctx, "key", 2))
+	require.Error(t, rl.Allow(ctx, "key"))
+
+	require.NoError(t, rl.Refund(ctx, "key", 1))
+	require.NoError(t, rl.Allow(ctx, "key"))
+	require.Error(t, rl.Allow(ctx, "key"))
+}
+
+func TestDisabledLimits(t *testing.T) {
+	ctx := context.Background()
+	rl := newRateLimit(models.RateLimit{Count: 0, Window: time.Hour})
+
+	for range 10 {
+		require.NoError(t, rl.AllowN(ctx, "key", 100))
+	}
+}
@@ -0,0 +1,77 @@
+package suggest
+
+import (
+	"context"
+	"fmt"
+	"github.com/IlianBuh/Follow_Service/internal/domain/models"
+	"github.com/IlianBuh/Follow_Service/internal/lib/logger/sl"
+	"log/slog"
+)
+
+type SuggestionsProvider interface {
+	Suggestions(ctx context.Context, uuid, sources, limit int) ([]models.Suggestion, error)
+}
+type Validator interface {
+	UUIDs(uuids ...int) error
+}
+
+const (
+	defaultLimit = 20
+	maxLimit     = 100
+
+	// maxSources is number of the most recent followees whose followees are considered
+	maxSources = 1000
+)
+
+type Suggest struct {
+	log    *slog.Logger
+	sgsPrv SuggestionsProvider
+	vld    Validator
+}
+
+// New returns new instance of suggestions service
+func New(
+	log *slog.Logger,
+	sgsPrv SuggestionsProvider,
+	vld Validator,
+) *Suggest {
+	return &Suggest{
+		log:    log,
+		sgsPrv: sgsPrv,
+		vld:    vld,
+	}
+}
+
+// SuggestFollows returns at most 'limit' users followed by followees of the user with the uuid,
+// ranked by number of connecting followees. The user, users already followed by the user
+// and blocked users are not suggested. Non-positive limit is replaced with default one
+func (s *Suggest) SuggestFollows(
+	ctx context.Context,
+	uuid int,
+	limit int,
+) ([]models.Suggestion, error) {
+	const op = "suggest.SuggestFollows"
+	log := s.log.With(slog.String("op", op))
+	log.Info("starting to suggest follows", slog.Int("uuid", uuid))
+
+	if err := s.vld.UUIDs(uuid); err != nil {
+		log.Warn("invalid uuid", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	switch {
+	case limit <= 0:
+		limit = defaultLimit
+	case limit > maxLimit:
+		limit = maxLimit
+	}
+
+	suggestions, err := s.sgsPrv.Suggestions(ctx, uuid, maxSources, limit)
+	if err != nil {
+		log.Error("failed to get suggestions", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	log.Info("successfully suggested follows")
+	return suggestions, nil
+}
@@ -0,0 +1,8 @@
+package watch
+
+import "errors"
+
+var (
+	ErrInvalidResumeToken = errors.New("invalid resume token")
+	ErrStopped            = errors.New("watching is stopped")
+)
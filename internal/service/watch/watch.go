@@ -0,0 +1,170 @@
+package watch
+
+import (
+	"context"
+	"fmt"
+	"github.com/IlianBuh/Follow_Service/internal/domain/models"
+	"github.com/IlianBuh/Follow_Service/internal/lib/cursor"
+	"github.com/IlianBuh/Follow_Service/internal/lib/logger/sl"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+type EventsProvider interface {
+	EventsAfter(ctx context.Context, after, uuid, limit int) ([]models.FollowEvent, error)
+	LastEventSeq(ctx context.Context) (int, error)
+}
+type Validator interface {
+	UUIDs(uuids ...int) error
+}
+
+type Watch struct {
+	log       *slog.Logger
+	evPrv     EventsProvider
+	interval  time.Duration
+	batchSize int
+	vld       Validator
+
+	stopOnce sync.Once
+	done     chan struct{}
+}
+
+// New returns new instance of the watch service. The outbox is polled every 'interval'
+// for at most 'batchSize' events
+func New(
+	log *slog.Logger,
+	evPrv EventsProvider,
+	interval time.Duration,
+	batchSize int,
+	vld Validator,
+) *Watch {
+	return &Watch{
+		log:       log,
+		evPrv:     evPrv,
+		interval:  interval,
+		batchSize: batchSize,
+		vld:       vld,
+		done:      make(chan struct{}),
+	}
+}
+
+// WatchFollows passes follow events of the user with uuid to 'send' in order they were committed,
+// together with the resume token pointing to the event. Events are watched once they are sequenced
+// by the relay.
+// Watching starts after the event the resume token points to, empty token means only new events.
+// It lasts until the context is done, 'send' fails or the service is stopped, in the last case
+// ErrStopped is returned and the client is expected to resume with the last received token
+func (w *Watch) WatchFollows(
+	ctx context.Context,
+	uuid int,
+	resumeToken string,
+	send func(event models.FollowEvent, resumeToken string) error,
+) error {
+	const op = "watch.WatchFollows"
+	log := w.log.With(slog.String("op", op), slog.Int("uuid", uuid))
+
+	if err := w.vld.UUIDs(uuid); err != nil {
+		log.Warn("invalid uuid", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := w.watch(ctx, log, uuid, resumeToken, send); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// WatchAllFollows passes follow events of all users to 'send' the same way as WatchFollows
+func (w *Watch) WatchAllFollows(
+	ctx context.Context,
+	resumeToken string,
+	send func(event models.FollowEvent, resumeToken string) error,
+) error {
+	const op = "watch.WatchAllFollows"
+	log := w.log.With(slog.String("op", op))
+
+	if err := w.watch(ctx, log, 0, resumeToken, send); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// watch polls the outbox for events of the user with uuid, zero uuid means all users
+func (w *Watch) watch(
+	ctx context.Context,
+	log *slog.Logger,
+	uuid int,
+	resumeToken string,
+	send func(event models.FollowEvent, resumeToken string) error,
+) error {
+	log.Info("starting to watch follow events")
+
+	after, err := w.start(ctx, resumeToken)
+	if err != nil {
+		log.Warn("failed to start watching", sl.Err(err))
+		return err
+	}
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		events, err := w.evPrv.EventsAfter(ctx, after, uuid, w.batchSize)
+		if err != nil {
+			if ctx.Err() != nil {
+				log.Info("watching is finished")
+				return nil
+			}
+
+			log.Error("failed to get events", sl.Err(err))
+			return err
+		}
+
+		for _, event := range events {
+			if err = send(event, cursor.Encode(event.Seq)); err != nil {
+				log.Warn("failed to send event", sl.Err(err))
+				return err
+			}
+			after = event.Seq
+		}
+
+		// the full batch means there may be more events, so they are read without waiting
+		if len(events) == w.batchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			log.Info("watching is finished")
+			return nil
+		case <-w.done:
+			log.Info("watching is stopped")
+			return ErrStopped
+		case <-ticker.C:
+		}
+	}
+}
+
+// Stop finishes all active watches with ErrStopped. Watches started after stopping
+// are finished immediately
+func (w *Watch) Stop() {
+	w.stopOnce.Do(func() {
+		close(w.done)
+	})
+}
+
+// start returns sequence number of the event after which watching starts
+func (w *Watch) start(ctx context.Context, resumeToken string) (int, error) {
+	if resumeToken == "" {
+		return w.evPrv.LastEventSeq(ctx)
+	}
+
+	after, err := cursor.Decode(resumeToken)
+	if err != nil {
+		return 0, ErrInvalidResumeToken
+	}
+
+	return after, nil
+}
@@ -5,4 +5,18 @@ import "errors"
 var (
 	ErrFollowing   = errors.New("user is already following")
 	ErrNoFollowing = errors.New("user has not followed")
+	ErrBlocking    = errors.New("user is already blocked")
+	ErrNoBlocking  = errors.New("user has not blocked")
+	ErrBlocked     = errors.New("following is blocked")
+	ErrRequested   = errors.New("follow request already exists")
+	ErrNoRequest   = errors.New("follow request does not exist")
+	ErrMuting      = errors.New("user is already muted")
+	ErrNoMuting    = errors.New("user has not muted")
+
+	ErrFolloweesLimit = errors.New("followees limit is exceeded")
+
+	ErrIdempotencyKeyExists   = errors.New("idempotency key already exists")
+	ErrIdempotencyKeyNotFound = errors.New("idempotency key not found")
+
+	ErrRateLimited = errors.New("rate limit is exceeded")
 )
@@ -0,0 +1,131 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"github.com/IlianBuh/Follow_Service/internal/domain/models"
+	"github.com/IlianBuh/Follow_Service/internal/storage"
+	"sync"
+	"time"
+)
+
+// sweepEvery is the number of hits after which the hits of all keys are swept
+const sweepEvery = 1024
+
+// Storage keeps the state of the process in memory. It is lost on restart and
+// is not shared between instances of the service
+type Storage struct {
+	mu sync.Mutex
+	// hits are the moments of hits of every key in ascending order
+	hits map[string][]time.Time
+	// maxWindow is the longest window of the taken limits, older hits are not needed
+	maxWindow time.Duration
+	taken     int
+}
+
+// New returns new in-memory storage
+func New() *Storage {
+	return &Storage{
+		hits: make(map[string][]time.Time),
+	}
+}
+
+// TakeHits records 'n' hits of the key at the moment 'at' if they do not exceed any of the limits.
+// Otherwise storage.ErrRateLimited is returned with the moment when the hits will be allowed,
+// the zero moment means they are never allowed as there are more of them than the limit
+func (s *Storage) TakeHits(
+	_ context.Context,
+	key string,
+	at time.Time,
+	n int,
+	limits []models.RateLimit,
+) (time.Time, error) {
+	const op = "memory.TakeHits"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, limit := range limits {
+		s.maxWindow = max(s.maxWindow, limit.Window)
+	}
+
+	s.taken++
+	if s.taken%sweepEvery == 0 {
+		s.sweep(at)
+	}
+
+	hits := expire(s.hits[key], at.Add(-s.maxWindow))
+
+	var retryAt time.Time
+	for _, limit := range limits {
+		if n > limit.Count {
+			s.setHits(key, hits)
+			return time.Time{}, fmt.Errorf("%s: %w", op, storage.ErrRateLimited)
+		}
+
+		inWindow := hits[firstAfter(hits, at.Add(-limit.Window)):]
+		if len(inWindow)+n <= limit.Count {
+			continue
+		}
+
+		// the hits are allowed when enough of the hits in the window leave it
+		allowedAt := inWindow[len(inWindow)+n-limit.Count-1].Add(limit.Window)
+		if allowedAt.After(retryAt) {
+			retryAt = allowedAt
+		}
+	}
+	if !retryAt.IsZero() {
+		s.setHits(key, hits)
+		return retryAt, fmt.Errorf("%s: %w", op, storage.ErrRateLimited)
+	}
+
+	for range n {
+		hits = append(hits, at)
+	}
+	s.hits[key] = hits
+	return time.Time{}, nil
+}
+
+// sweep drops the hits of all keys which are older than the longest window
+func (s *Storage) sweep(at time.Time) {
+	for key, hits := range s.hits {
+		s.setHits(key, expire(hits, at.Add(-s.maxWindow)))
+	}
+}
+
+// ReturnHits removes 'n' latest hits of the key, so they are not counted to the limits anymore
+func (s *Storage) ReturnHits(_ context.Context, key string, n int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	hits := s.hits[key]
+	s.setHits(key, hits[:max(len(hits)-n, 0)])
+
+	return nil
+}
+
+// setHits stores the hits of the key, the key without hits is removed
+func (s *Storage) setHits(key string, hits []time.Time) {
+	if len(hits) == 0 {
+		delete(s.hits, key)
+		return
+	}
+
+	s.hits[key] = hits
+}
+
+// expire drops the hits which are not after the moment
+func expire(hits []time.Time, before time.Time) []time.Time {
+	return hits[firstAfter(hits, before):]
+}
+
+// firstAfter returns the index of the first hit which is after the moment
+func firstAfter(hits []time.Time, moment time.Time) int {
+	for i, v := range hits {
+		if v.After(moment) {
+			return i
+		}
+	}
+
+	return len(hits)
+}
@@ -0,0 +1,93 @@
+package memory_test
+
+import (
+	"context"
+	"github.com/IlianBuh/Follow_Service/internal/domain/models"
+	"github.com/IlianBuh/Follow_Service/internal/storage"
+	"github.com/IlianBuh/Follow_Service/internal/storage/memory"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var (
+	t0     = time.Unix(1700000000, 0)
+	limits = []models.RateLimit{
+		{Count: 3, Window: time.Minute},
+		{Count: 5, Window: time.Hour},
+	}
+)
+
+func TestTakeHitsSlidingWindow(t *testing.T) {
+	ctx := context.Background()
+	st := memory.New()
+
+	for _, at := range []time.Duration{0, 10 * time.Second, 20 * time.Second} {
+		_, err := st.TakeHits(ctx, "key", t0.Add(at), 1, limits)
+		require.NoError(t, err)
+	}
+
+	// the minute window is full until the first hit leaves it
+	retryAt, err := st.TakeHits(ctx, "key", t0.Add(30*time.Second), 1, limits)
+	require.ErrorIs(t, err, storage.ErrRateLimited)
+	require.Equal(t, t0.Add(time.Minute), retryAt)
+
+	// rejected hits are not recorded, so the window slides by the accepted ones
+	_, err = st.TakeHits(ctx, "key", t0.Add(time.Minute+time.Second), 1, limits)
+	require.NoError(t, err)
+
+	// other keys are limited independently
+	_, err = st.TakeHits(ctx, "other", t0.Add(30*time.Second), 3, limits)
+	require.NoError(t, err)
+}
+
+func TestTakeHitsRetryAfter(t *testing.T) {
+	ctx := context.Background()
+	st := memory.New()
+
+	_, err := st.TakeHits(ctx, "key", t0, 2, limits)
+	require.NoError(t, err)
+	_, err = st.TakeHits(ctx, "key", t0.Add(10*time.Second), 1, limits)
+	require.NoError(t, err)
+
+	// two hits need two of the hits to leave the minute window
+	retryAt, err := st.TakeHits(ctx, "key", t0.Add(20*time.Second), 2, limits)
+	require.ErrorIs(t, err, storage.ErrRateLimited)
+	require.Equal(t, t0.Add(time.Minute), retryAt)
+
+	// three hits exceed the hour window too, so the latest of the moments is taken
+	retryAt, err = st.TakeHits(ctx, "key", t0.Add(20*time.Second), 3, limits)
+	require.ErrorIs(t, err, storage.ErrRateLimited)
+	require.Equal(t, t0.Add(time.Hour), retryAt)
+
+	// the hour window is full while the minute one is empty
+	_, err = st.TakeHits(ctx, "key", t0.Add(2*time.Minute), 2, limits)
+	require.NoError(t, err)
+	retryAt, err = st.TakeHits(ctx, "key", t0.Add(4*time.Minute), 1, limits)
+	require.ErrorIs(t, err, storage.ErrRateLimited)
+	require.Equal(t, t0.Add(time.Hour), retryAt)
+
+	// more hits than the limit are never allowed
+	retryAt, err = st.TakeHits(ctx, "fresh", t0, 4, limits)
+	require.ErrorIs(t, err, storage.ErrRateLimited)
+	require.True(t, retryAt.IsZero())
+}
+
+func TestReturnHits(t *testing.T) {
+	ctx := context.Background()
+	st := memory.New()
+
+	_, err := st.TakeHits(ctx, "key", t0, 3, limits)
+	require.NoError(t, err)
+	_, err = st.TakeHits(ctx, "key", t0, 1, limits)
+	require.ErrorIs(t, err, storage.ErrRateLimited)
+
+	require.NoError(t, st.ReturnHits(ctx, "key", 2))
+	_, err = st.TakeHits(ctx, "key", t0, 2, limits)
+	require.NoError(t, err)
+
+	// returning more hits than taken clears the key
+	require.NoError(t, st.ReturnHits(ctx, "key", 10))
+	_, err = st.TakeHits(ctx, "key", t0, 3, limits)
+	require.NoError(t, err)
+}
@@ -0,0 +1,136 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/IlianBuh/Follow_Service/internal/storage"
+	"time"
+)
+
+// Block adds the tuple (src, target) into blocks and removes followings and
+// follow requests between the users in both directions
+func (s *Storage) Block(ctx context.Context, src, target int) error {
+	const op = "postgres.Block"
+
+	err := s.withTx(ctx, func(tx *sql.Tx) error {
+		err := lockPair(ctx, tx, src, target)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(
+			ctx,
+			`INSERT INTO blocks(blocker, blocked, created_at) VALUES($1, $2, $3)`,
+			src, target, time.Now(),
+		)
+		if err != nil {
+			if isUniqueViolation(err) {
+				return storage.ErrBlocking
+			}
+
+			return err
+		}
+
+		_, err = tx.ExecContext(
+			ctx,
+			`DELETE FROM follow_requests WHERE (follower=$1 AND followee=$2) OR (follower=$2 AND followee=$1)`,
+			src, target,
+		)
+		if err != nil {
+			return err
+		}
+
+		if err = lockCounters(ctx, tx, src, target); err != nil {
+			return err
+		}
+
+		if _, err = removeFollowing(ctx, tx, src, target); err != nil {
+			return err
+		}
+
+		_, err = removeFollowing(ctx, tx, target, src)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// Unblock deletes the tuple (src, target) from blocks
+func (s *Storage) Unblock(ctx context.Context, src, target int) error {
+	const op = "postgres.Unblock"
+
+	res, err := s.db.ExecContext(ctx, `DELETE FROM blocks WHERE blocker=$1 AND blocked=$2`, src, target)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrNoBlocking)
+	}
+
+	return nil
+}
+
+// ListBlocked returns at most 'limit' users blocked by the user with uuid ordered by id. Only blocks
+// with id greater than 'after' are returned. The second value is the id of the last returned
+// block if there are more blocked users to list, otherwise zero
+func (s *Storage) ListBlocked(ctx context.Context, uuid, after, limit int) ([]int, int, error) {
+	const op = "postgres.ListBlocked"
+
+	list, next, err := s.listPage(
+		ctx,
+		`SELECT id, blocked, created_at FROM blocks WHERE blocker=$1 AND id>$2 ORDER BY id LIMIT $3`,
+		uuid, after, limit,
+	)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	uuids := make([]int, len(list))
+	for i, v := range list {
+		uuids[i] = v.UUID
+	}
+
+	return uuids, next, nil
+}
+
+// checkNotBlocked returns storage.ErrBlocked if any of the users has blocked the other one
+func checkNotBlocked(ctx context.Context, tx *sql.Tx, src, target int) error {
+	var blocked bool
+
+	err := tx.QueryRowContext(
+		ctx,
+		`SELECT EXISTS(
+			SELECT 1 FROM blocks WHERE (blocker=$1 AND blocked=$2) OR (blocker=$2 AND blocked=$1)
+		)`,
+		src, target,
+	).Scan(&blocked)
+	if err != nil {
+		return err
+	}
+	if blocked {
+		return storage.ErrBlocked
+	}
+
+	return nil
+}
+
+// lockPair takes transaction-level lock on the pair of users, so concurrent
+// follows and blocks between the same users are serialized
+func lockPair(ctx context.Context, tx *sql.Tx, a, b int) error {
+	if a > b {
+		a, b = b, a
+	}
+
+	_, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock($1, $2)`, int32(a), int32(b))
+
+	return err
+}
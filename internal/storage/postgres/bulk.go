@@ -0,0 +1,157 @@
+package postgres
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/IlianBuh/Follow_Service/internal/domain/models"
+	"github.com/IlianBuh/Follow_Service/internal/storage"
+	"slices"
+)
+
+// BulkFollow follows user src on every target in one transaction and returns the result
+// for every target in order of targets. Private targets get follow requests instead.
+// ErrFolloweesLimit is returned if following all targets could make src follow more than
+// 'maxFollowees' users, zero means no limit
+func (s *Storage) BulkFollow(
+	ctx context.Context,
+	src int,
+	targets []int,
+	maxFollowees int,
+) ([]models.BulkResult, error) {
+	const op = "postgres.BulkFollow"
+
+	res := make([]models.BulkResult, len(targets))
+	err := s.withTx(ctx, func(tx *sql.Tx) error {
+		err := lockPairs(ctx, tx, src, targets)
+		if err != nil {
+			return err
+		}
+
+		for _, target := range targets {
+			if _, err = lockPrivacy(ctx, tx, target); err != nil {
+				return err
+			}
+		}
+
+		if err = lockCounters(ctx, tx, append([]int{src}, targets...)...); err != nil {
+			return err
+		}
+
+		if err = checkFolloweesLimit(ctx, tx, src, len(targets), maxFollowees); err != nil {
+			return err
+		}
+
+		for i, target := range targets {
+			status, err := followOne(ctx, tx, src, target)
+			if err != nil {
+				return err
+			}
+
+			res[i] = models.BulkResult{UUID: target, Status: status}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return res, nil
+}
+
+// BulkUnfollow unfollows user src from every target in one transaction and returns
+// the result for every target in order of targets
+func (s *Storage) BulkUnfollow(ctx context.Context, src int, targets []int) ([]models.BulkResult, error) {
+	const op = "postgres.BulkUnfollow"
+
+	res := make([]models.BulkResult, len(targets))
+	err := s.withTx(ctx, func(tx *sql.Tx) error {
+		err := lockCounters(ctx, tx, append([]int{src}, targets...)...)
+		if err != nil {
+			return err
+		}
+
+		for i, target := range targets {
+			removed, err := removeFollowing(ctx, tx, src, target)
+			if err != nil {
+				return err
+			}
+
+			res[i] = models.BulkResult{UUID: target, Status: models.BulkStatusNotFollowing}
+			if removed {
+				res[i].Status = models.BulkStatusUnfollowed
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return res, nil
+}
+
+// followOne follows user src on target inside the transaction. Expected failures
+// are converted to the bulk status, only unexpected ones are returned as errors
+func followOne(ctx context.Context, tx *sql.Tx, src, target int) (models.BulkStatus, error) {
+	err := checkNotBlocked(ctx, tx, src, target)
+	if err != nil {
+		if errors.Is(err, storage.ErrBlocked) {
+			return models.BulkStatusBlocked, nil
+		}
+
+		return 0, err
+	}
+
+	private, err := isPrivate(ctx, tx, target)
+	if err != nil {
+		return 0, err
+	}
+
+	status := models.BulkStatusFollowed
+	if private {
+		status = models.BulkStatusRequested
+		err = insertRequest(ctx, tx, src, target)
+	} else {
+		err = insertFollowing(ctx, tx, src, target)
+	}
+
+	switch {
+	case errors.Is(err, storage.ErrFollowing):
+		return models.BulkStatusAlreadyFollowing, nil
+	case errors.Is(err, storage.ErrRequested):
+		return models.BulkStatusAlreadyRequested, nil
+	case err != nil:
+		return 0, err
+	}
+
+	return status, nil
+}
+
+// lockPairs takes locks on pairs of src with every target. Locks are taken in the same global
+// order of pairs as in any other transaction, so concurrent bulk follows can't deadlock
+func lockPairs(ctx context.Context, tx *sql.Tx, src int, targets []int) error {
+	pairs := make([][2]int, len(targets))
+	for i, target := range targets {
+		pairs[i] = [2]int{min(src, target), max(src, target)}
+	}
+	slices.SortFunc(pairs, func(a, b [2]int) int {
+		if c := cmp.Compare(a[0], b[0]); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(a[1], b[1])
+	})
+
+	for _, v := range pairs {
+		if err := lockPair(ctx, tx, v[0], v[1]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
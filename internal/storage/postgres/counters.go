@@ -0,0 +1,115 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/IlianBuh/Follow_Service/internal/storage"
+	"slices"
+)
+
+// CountFollowers returns number of followers of the user with uuid
+func (s *Storage) CountFollowers(ctx context.Context, uuid int) (int, error) {
+	const op = "postgres.CountFollowers"
+
+	count, err := s.counter(ctx, `SELECT followers FROM follow_counters WHERE uuid=$1`, uuid)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return count, nil
+}
+
+// CountFollowees returns number of followees of the user with uuid
+func (s *Storage) CountFollowees(ctx context.Context, uuid int) (int, error) {
+	const op = "postgres.CountFollowees"
+
+	count, err := s.counter(ctx, `SELECT followees FROM follow_counters WHERE uuid=$1`, uuid)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return count, nil
+}
+
+// counter executes query selecting one counter of the user. User without counters row has zero counter
+func (s *Storage) counter(ctx context.Context, query string, uuid int) (int, error) {
+	var count int
+
+	err := s.db.QueryRowContext(ctx, query, uuid).Scan(&count)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, nil
+		}
+
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// addCounters adds delta to the followees counter of src and to the followers counter of target
+func addCounters(ctx context.Context, tx *sql.Tx, src, target, delta int) error {
+	_, err := tx.ExecContext(
+		ctx,
+		`INSERT INTO follow_counters(uuid, followees) VALUES($1, $2)
+			ON CONFLICT(uuid) DO UPDATE SET followees=follow_counters.followees+excluded.followees`,
+		src, delta,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(
+		ctx,
+		`INSERT INTO follow_counters(uuid, followers) VALUES($1, $2)
+			ON CONFLICT(uuid) DO UPDATE SET followers=follow_counters.followers+excluded.followers`,
+		target, delta,
+	)
+
+	return err
+}
+
+// lockCounters locks counters rows of the users creating missing ones. Rows are locked in
+// ascending order of uuids, so transactions updating counters of overlapping users, like
+// follows 1->2, 2->3 and 3->1, wait for each other instead of deadlocking. It must be called
+// before any counter of the users is read or updated in the transaction
+func lockCounters(ctx context.Context, tx *sql.Tx, uuids ...int) error {
+	uuids = slices.Clone(uuids)
+	slices.Sort(uuids)
+
+	for _, uuid := range slices.Compact(uuids) {
+		_, err := tx.ExecContext(
+			ctx,
+			`INSERT INTO follow_counters(uuid) VALUES($1)
+				ON CONFLICT(uuid) DO UPDATE SET followees=follow_counters.followees`,
+			uuid,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkFolloweesLimit returns storage.ErrFolloweesLimit if following 'n' more users makes the user
+// follow more than 'limit' users. Zero limit means no limit. Counters of the user must be locked
+// by lockCounters, so concurrent follows of the user wait for the transaction
+func checkFolloweesLimit(ctx context.Context, tx *sql.Tx, uuid, n, limit int) error {
+	if limit <= 0 {
+		return nil
+	}
+
+	var followees int
+	err := tx.QueryRowContext(ctx, `SELECT followees FROM follow_counters WHERE uuid=$1`, uuid).Scan(&followees)
+	if err != nil {
+		return err
+	}
+	if followees+n > limit {
+		return storage.ErrFolloweesLimit
+	}
+
+	return nil
+}
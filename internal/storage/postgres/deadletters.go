@@ -0,0 +1,25 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"github.com/IlianBuh/Follow_Service/internal/domain/models"
+	"time"
+)
+
+// SaveDeadLetter stores the event which could not be delivered
+func (s *Storage) SaveDeadLetter(ctx context.Context, letter models.DeadLetter) error {
+	const op = "postgres.SaveDeadLetter"
+
+	_, err := s.db.ExecContext(
+		ctx,
+		`INSERT INTO dead_letters(event_id, endpoint, payload, error, attempts, created_at)
+			VALUES($1, $2, $3, $4, $5, $6)`,
+		letter.EventID, letter.Endpoint, string(letter.Payload), letter.Error, letter.Attempts, time.Now(),
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
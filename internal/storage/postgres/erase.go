@@ -0,0 +1,108 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/IlianBuh/Follow_Service/internal/domain/models"
+	"time"
+)
+
+// DeleteUserGraph removes in one transaction all followings, follow requests, blocks and mutes
+// in which the user with uuid takes part, together with counters and settings of the user.
+// Counters of the other users are decremented and unfollowed events are emitted for all followings
+func (s *Storage) DeleteUserGraph(ctx context.Context, uuid int) (models.DeletedGraph, error) {
+	const op = "postgres.DeleteUserGraph"
+
+	var res models.DeletedGraph
+	err := s.withTx(ctx, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(
+			ctx,
+			`INSERT INTO outbox(type, follower, followee, created_at)
+				SELECT $2, follower, followee, $3 FROM followings WHERE follower=$1 OR followee=$1`,
+			uuid, string(models.FollowEventUnfollowed), time.Now(),
+		)
+		if err != nil {
+			return err
+		}
+
+		// counters are locked in the same order as by lockCounters
+		_, err = tx.ExecContext(
+			ctx,
+			`SELECT uuid FROM follow_counters
+				WHERE uuid=$1
+					OR uuid IN (SELECT followee FROM followings WHERE follower=$1)
+					OR uuid IN (SELECT follower FROM followings WHERE followee=$1)
+				ORDER BY uuid FOR UPDATE`,
+			uuid,
+		)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(
+			ctx,
+			`UPDATE follow_counters SET followers=followers-1
+				WHERE uuid IN (SELECT followee FROM followings WHERE follower=$1)`,
+			uuid,
+		)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(
+			ctx,
+			`UPDATE follow_counters SET followees=followees-1
+				WHERE uuid IN (SELECT follower FROM followings WHERE followee=$1)`,
+			uuid,
+		)
+		if err != nil {
+			return err
+		}
+
+		steps := []struct {
+			query string
+			count *int
+		}{
+			{`DELETE FROM followings WHERE follower=$1`, &res.Followees},
+			{`DELETE FROM followings WHERE followee=$1`, &res.Followers},
+			{`DELETE FROM follow_requests WHERE follower=$1 OR followee=$1`, &res.Requests},
+			{`DELETE FROM blocks WHERE blocker=$1 OR blocked=$1`, &res.Blocks},
+			{`DELETE FROM mutes WHERE muter=$1 OR muted=$1`, &res.Mutes},
+			{`DELETE FROM follow_counters WHERE uuid=$1`, nil},
+			{`DELETE FROM account_settings WHERE uuid=$1`, nil},
+		}
+		for _, step := range steps {
+			affected, err := execAffected(ctx, tx, step.query, uuid)
+			if err != nil {
+				return err
+			}
+
+			if step.count != nil {
+				*step.count = affected
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return models.DeletedGraph{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return res, nil
+}
+
+// execAffected executes query inside the transaction and returns number of affected rows
+func execAffected(ctx context.Context, tx *sql.Tx, query string, args ...any) (int, error) {
+	res, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(affected), nil
+}
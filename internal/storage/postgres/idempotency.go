@@ -0,0 +1,73 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/IlianBuh/Follow_Service/internal/domain/models"
+	"github.com/IlianBuh/Follow_Service/internal/storage"
+	"time"
+)
+
+// SaveIdempotencyKey stores the outcome of the request made with the idempotency key.
+// Keys created before 'expiredBefore' are removed first, so expired keys may be reused.
+// ErrIdempotencyKeyExists is returned if the key is already stored
+func (s *Storage) SaveIdempotencyKey(ctx context.Context, key models.IdempotencyKey, expiredBefore time.Time) error {
+	const op = "postgres.SaveIdempotencyKey"
+
+	err := s.withTx(ctx, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `DELETE FROM idempotency_keys WHERE created_at<$1`, expiredBefore)
+		if err != nil {
+			return err
+		}
+
+		res, err := tx.ExecContext(
+			ctx,
+			`INSERT INTO idempotency_keys(idempotency_key, fingerprint, status, response, created_at)
+				VALUES($1, $2, $3, $4, $5) ON CONFLICT DO NOTHING`,
+			key.Key, key.Fingerprint, key.Status, key.Response, key.CreatedAt,
+		)
+		if err != nil {
+			return err
+		}
+
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if affected == 0 {
+			return storage.ErrIdempotencyKeyExists
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// IdempotencyKey returns the stored outcome of the request made with the key.
+// ErrIdempotencyKeyNotFound is returned if there is no such key created since 'expiredBefore'
+func (s *Storage) IdempotencyKey(ctx context.Context, key string, expiredBefore time.Time) (models.IdempotencyKey, error) {
+	const op = "postgres.IdempotencyKey"
+
+	var res models.IdempotencyKey
+	err := s.db.QueryRowContext(
+		ctx,
+		`SELECT idempotency_key, fingerprint, status, response, created_at FROM idempotency_keys
+			WHERE idempotency_key=$1 AND created_at>=$2`,
+		key, expiredBefore,
+	).Scan(&res.Key, &res.Fingerprint, &res.Status, &res.Response, &res.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.IdempotencyKey{}, fmt.Errorf("%s: %w", op, storage.ErrIdempotencyKeyNotFound)
+		}
+
+		return models.IdempotencyKey{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,49 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"github.com/IlianBuh/Follow_Service/internal/storage"
+	"time"
+)
+
+// Mute adds the tuple (src, target) into mutes. Muted followees are still followed
+// but may be excluded from the list of followees
+func (s *Storage) Mute(ctx context.Context, src, target int) error {
+	const op = "postgres.Mute"
+
+	_, err := s.db.ExecContext(
+		ctx,
+		`INSERT INTO mutes(muter, muted, created_at) VALUES($1, $2, $3)`,
+		src, target, time.Now(),
+	)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return fmt.Errorf("%s: %w", op, storage.ErrMuting)
+		}
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// Unmute deletes the tuple (src, target) from mutes
+func (s *Storage) Unmute(ctx context.Context, src, target int) error {
+	const op = "postgres.Unmute"
+
+	res, err := s.db.ExecContext(ctx, `DELETE FROM mutes WHERE muter=$1 AND muted=$2`, src, target)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrNoMuting)
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+)
+
+// FolloweesOf returns followees of every user from uuids keyed by the user.
+// At most 'limit' followings are fetched in total
+func (s *Storage) FolloweesOf(ctx context.Context, uuids []int, limit int) (map[int][]int, error) {
+	const op = "postgres.FolloweesOf"
+
+	res, err := s.neighbours(ctx, `SELECT follower, followee FROM followings WHERE follower=ANY($1) LIMIT $2`, uuids, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return res, nil
+}
+
+// FollowersOf returns followers of every user from uuids keyed by the user.
+// At most 'limit' followings are fetched in total
+func (s *Storage) FollowersOf(ctx context.Context, uuids []int, limit int) (map[int][]int, error) {
+	const op = "postgres.FollowersOf"
+
+	res, err := s.neighbours(ctx, `SELECT followee, follower FROM followings WHERE followee=ANY($1) LIMIT $2`, uuids, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return res, nil
+}
+
+// neighbours executes query selecting (uuid, neighbour) rows for the list of uuids
+func (s *Storage) neighbours(ctx context.Context, query string, uuids []int, limit int) (map[int][]int, error) {
+	res := make(map[int][]int, len(uuids))
+	if len(uuids) == 0 {
+		return res, nil
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, uuids, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var uuid, neighbour int
+	for rows.Next() {
+		err = rows.Scan(&uuid, &neighbour)
+		if err != nil {
+			return nil, err
+		}
+
+		res[uuid] = append(res[uuid], neighbour)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,206 @@
+package postgres
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/IlianBuh/Follow_Service/internal/domain/models"
+	"slices"
+	"time"
+)
+
+// ClaimEvents leases at most 'limit' unpublished events to the owner until 'leasedUntil' and
+// returns them in order they were emitted. Events leased by another owner are skipped until
+// the lease expires, events leased by the owner are returned again. Rows being claimed
+// concurrently are skipped, so replicas never claim the same event at the same time
+func (s *Storage) ClaimEvents(
+	ctx context.Context,
+	owner string,
+	leasedUntil time.Time,
+	limit int,
+) ([]models.FollowEvent, error) {
+	const op = "postgres.ClaimEvents"
+
+	rows, err := s.db.QueryContext(
+		ctx,
+		`UPDATE outbox SET lease_owner=$1, leased_until=$2 WHERE id IN (
+			SELECT id FROM outbox
+				WHERE published_at IS NULL AND (leased_until IS NULL OR leased_until<$3 OR lease_owner=$1)
+				ORDER BY id LIMIT $4 FOR UPDATE SKIP LOCKED
+		) RETURNING id, COALESCE(seq, 0), type, follower, followee, created_at`,
+		owner, leasedUntil, time.Now(), limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	events, err := scanEvents(rows, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	slices.SortFunc(events, func(a, b models.FollowEvent) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	return events, nil
+}
+
+// MarkPublished marks events with ids as published
+func (s *Storage) MarkPublished(ctx context.Context, ids []int) error {
+	const op = "postgres.MarkPublished"
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	_, err := s.db.ExecContext(ctx, `UPDATE outbox SET published_at=$1 WHERE id=ANY($2)`, time.Now(), ids)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// sequenceLock is the key of the advisory lock serializing sequencing of events
+const sequenceLock = 716203456
+
+// SequenceEvents assigns sequence numbers to the committed events which do not have them yet,
+// in order of their ids. Ids are taken from the sequence before the commit, so transactions may
+// commit their events out of order of ids. Sequencing is serialized by the advisory lock and sees
+// only committed events, so the events committed later always get greater numbers
+func (s *Storage) SequenceEvents(ctx context.Context) error {
+	const op = "postgres.SequenceEvents"
+
+	// the lock is not taken while there is nothing to sequence
+	var unsequenced bool
+	err := s.db.QueryRowContext(ctx, `SELECT EXISTS(SELECT 1 FROM outbox WHERE seq IS NULL)`).Scan(&unsequenced)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if !unsequenced {
+		return nil
+	}
+
+	err = s.withTx(ctx, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock($1)`, sequenceLock)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(
+			ctx,
+			`UPDATE outbox SET seq=s.seq FROM (
+				SELECT id, (SELECT COALESCE(MAX(seq), 0) FROM outbox) + ROW_NUMBER() OVER (ORDER BY id) AS seq
+					FROM outbox WHERE seq IS NULL
+			) s WHERE outbox.id=s.id`,
+		)
+
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// DeletePublishedEvents deletes at most 'limit' sequenced events published before the moment
+// and returns the number of deleted events
+func (s *Storage) DeletePublishedEvents(ctx context.Context, before time.Time, limit int) (int, error) {
+	const op = "postgres.DeletePublishedEvents"
+
+	res, err := s.db.ExecContext(
+		ctx,
+		`DELETE FROM outbox WHERE id IN (
+			SELECT id FROM outbox WHERE published_at<$1 AND seq IS NOT NULL LIMIT $2
+		)`,
+		before, limit,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return int(deleted), nil
+}
+
+// EventsAfter returns at most 'limit' sequenced events with sequence number greater than 'after'
+// in order they were committed. Only events where the user with uuid is the follower or the followee
+// are returned, zero uuid means events of all users
+func (s *Storage) EventsAfter(ctx context.Context, after, uuid, limit int) ([]models.FollowEvent, error) {
+	const op = "postgres.EventsAfter"
+
+	query, args := `SELECT id, seq, type, follower, followee, created_at FROM outbox
+		WHERE seq>$1 ORDER BY seq LIMIT $2`, []any{after, limit}
+	if uuid != 0 {
+		query, args = `SELECT id, seq, type, follower, followee, created_at FROM outbox
+			WHERE seq>$1 AND (follower=$2 OR followee=$2) ORDER BY seq LIMIT $3`, []any{after, uuid, limit}
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	events, err := scanEvents(rows, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return events, nil
+}
+
+// LastEventSeq returns sequence number of the last sequenced event or zero if there are no such events
+func (s *Storage) LastEventSeq(ctx context.Context) (int, error) {
+	const op = "postgres.LastEventSeq"
+
+	var seq int
+	err := s.db.QueryRowContext(ctx, `SELECT COALESCE(MAX(seq), 0) FROM outbox`).Scan(&seq)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return seq, nil
+}
+
+// insertEvent adds the event about the following (follower, followee) into the outbox
+func insertEvent(ctx context.Context, tx *sql.Tx, typ models.FollowEventType, follower, followee int) error {
+	_, err := tx.ExecContext(
+		ctx,
+		`INSERT INTO outbox(type, follower, followee, created_at) VALUES($1, $2, $3, $4)`,
+		string(typ), follower, followee, time.Now(),
+	)
+
+	return err
+}
+
+// scanEvents reads (id, seq, type, follower, followee, created_at) rows of the outbox
+func scanEvents(rows *sql.Rows, size int) ([]models.FollowEvent, error) {
+	events := make([]models.FollowEvent, 0, size)
+	var (
+		temp models.FollowEvent
+		typ  string
+	)
+	for rows.Next() {
+		err := rows.Scan(&temp.ID, &temp.Seq, &typ, &temp.Follower, &temp.Followee, &temp.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		temp.Type = models.FollowEventType(typ)
+		events = append(events, temp)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
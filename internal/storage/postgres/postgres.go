@@ -0,0 +1,384 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/IlianBuh/Follow_Service/internal/domain/models"
+	"github.com/IlianBuh/Follow_Service/internal/storage"
+	"github.com/jackc/pgx/v5/pgconn"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"math"
+	"time"
+)
+
+// uniqueViolation is the postgres error code of unique constraint violation
+const uniqueViolation = "23505"
+
+type Storage struct {
+	db *sql.DB
+}
+
+// New returns new instance of the repository. Database is connected by 'url'.
+// Schema of the database is maintained by the migrator
+func New(url string) (*Storage, error) {
+	const op = "postgres.New"
+	db, err := sql.Open("pgx", url)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &Storage{
+		db: db,
+	}, nil
+}
+
+// Follow add new tuple into the database and increments counters of both users.
+// If the target account is private, the follow request is stored instead.
+// ErrFolloweesLimit is returned if src already follows 'maxFollowees' users, zero means no limit
+func (s *Storage) Follow(ctx context.Context, src, target, maxFollowees int) (models.FollowState, error) {
+	const op = "postgres.Follow"
+
+	state := models.FollowStateFollowed
+	err := s.withTx(ctx, func(tx *sql.Tx) error {
+		err := lockPair(ctx, tx, src, target)
+		if err != nil {
+			return err
+		}
+
+		if err = checkNotBlocked(ctx, tx, src, target); err != nil {
+			return err
+		}
+
+		private, err := lockPrivacy(ctx, tx, target)
+		if err != nil {
+			return err
+		}
+
+		if err = lockCounters(ctx, tx, src, target); err != nil {
+			return err
+		}
+
+		if err = checkFolloweesLimit(ctx, tx, src, 1, maxFollowees); err != nil {
+			return err
+		}
+
+		if private {
+			state = models.FollowStateRequested
+			return insertRequest(ctx, tx, src, target)
+		}
+
+		return insertFollowing(ctx, tx, src, target)
+	})
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return state, nil
+}
+
+// Unfollow delete the tuple (src, target) from the database and decrements counters
+// of both users. ErrNoFollowing is returned if the tuple does not exist
+func (s *Storage) Unfollow(ctx context.Context, src, target int) error {
+	const op = "postgres.Unfollow"
+
+	err := s.withTx(ctx, func(tx *sql.Tx) error {
+		err := lockCounters(ctx, tx, src, target)
+		if err != nil {
+			return err
+		}
+
+		removed, err := removeFollowing(ctx, tx, src, target)
+		if err != nil {
+			return err
+		}
+		if !removed {
+			return storage.ErrNoFollowing
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// ListFollowers returns at most 'limit' followers of the user with uuid ordered by id, from
+// the newest if 'newestFirst' is set. Only followings after the one with id 'after' are returned,
+// zero 'after' means the beginning of the list. The second value is the id of the last returned
+// following if there are more followers to list, otherwise zero
+func (s *Storage) ListFollowers(
+	ctx context.Context,
+	uuid, after, limit int,
+	newestFirst bool,
+) ([]models.Following, int, error) {
+	const op = "postgres.ListFollowers"
+
+	query := `SELECT id, follower, created_at FROM followings WHERE followee=$1 AND id>$2 ORDER BY id LIMIT $3`
+	if newestFirst {
+		query = `SELECT id, follower, created_at FROM followings WHERE followee=$1 AND id<$2 ORDER BY id DESC LIMIT $3`
+		after = descCursor(after)
+	}
+
+	list, next, err := s.listPage(ctx, query, uuid, after, limit)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return list, next, nil
+}
+
+// ListFollowees returns at most 'limit' followees of the user with uuid ordered by id, from
+// the newest if 'newestFirst' is set. Muted followees are skipped if 'excludeMuted' is set.
+// Only followings after the one with id 'after' are returned, zero 'after' means the beginning
+// of the list. The second value is the id of the last returned following if there are more
+// followees to list, otherwise zero
+func (s *Storage) ListFollowees(
+	ctx context.Context,
+	uuid, after, limit int,
+	newestFirst, excludeMuted bool,
+) ([]models.Following, int, error) {
+	const op = "postgres.ListFollowees"
+
+	filter := ""
+	if excludeMuted {
+		filter = ` AND NOT EXISTS (
+			SELECT 1 FROM mutes WHERE mutes.muter=followings.follower AND mutes.muted=followings.followee
+		)`
+	}
+
+	query := `SELECT id, followee, created_at FROM followings WHERE follower=$1 AND id>$2` + filter + ` ORDER BY id LIMIT $3`
+	if newestFirst {
+		query = `SELECT id, followee, created_at FROM followings WHERE follower=$1 AND id<$2` + filter + ` ORDER BY id DESC LIMIT $3`
+		after = descCursor(after)
+	}
+
+	list, next, err := s.listPage(ctx, query, uuid, after, limit)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return list, next, nil
+}
+
+// CommonFollowers returns at most 'limit' followees of the viewer who follow the target,
+// from the most recently followed by the viewer, and the total number of such users
+func (s *Storage) CommonFollowers(ctx context.Context, viewer, target, limit int) ([]int, int, error) {
+	const op = "postgres.CommonFollowers"
+
+	rows, err := s.db.QueryContext(
+		ctx,
+		`SELECT a.followee, COUNT(*) OVER () FROM followings a
+			JOIN followings b ON b.follower=a.followee
+			WHERE a.follower=$1 AND b.followee=$2
+			ORDER BY a.id DESC LIMIT $3`,
+		viewer, target, limit,
+	)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	sample := make([]int, 0, limit)
+	var temp, count int
+	for rows.Next() {
+		err = rows.Scan(&temp, &count)
+		if err != nil {
+			return nil, 0, fmt.Errorf("%s: %w", op, err)
+		}
+
+		sample = append(sample, temp)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return sample, count, nil
+}
+
+// StreamFollowers reads all followers of the user with uuid straight from the database cursor
+// and passes them to 'send' by chunks of at most 'size' elements. The chunk slice is reused
+// between calls, so 'send' must not retain it
+func (s *Storage) StreamFollowers(ctx context.Context, uuid, size int, send func([]int) error) error {
+	const op = "postgres.StreamFollowers"
+
+	err := s.stream(ctx, `SELECT follower FROM followings WHERE followee=$1 ORDER BY id`, uuid, size, send)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// StreamFollowees reads all followees of the user with uuid straight from the database cursor
+// and passes them to 'send' by chunks of at most 'size' elements. The chunk slice is reused
+// between calls, so 'send' must not retain it
+func (s *Storage) StreamFollowees(ctx context.Context, uuid, size int, send func([]int) error) error {
+	const op = "postgres.StreamFollowees"
+
+	err := s.stream(ctx, `SELECT followee FROM followings WHERE follower=$1 ORDER BY id`, uuid, size, send)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// stream executes query selecting uuids and passes result rows to 'send' by chunks
+func (s *Storage) stream(ctx context.Context, query string, uuid, size int, send func([]int) error) error {
+	rows, err := s.db.QueryContext(ctx, query, uuid)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	chunk := make([]int, 0, size)
+	var temp int
+	for rows.Next() {
+		err = rows.Scan(&temp)
+		if err != nil {
+			return err
+		}
+
+		chunk = append(chunk, temp)
+		if len(chunk) == size {
+			if err = send(chunk); err != nil {
+				return err
+			}
+			chunk = chunk[:0]
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
+	if len(chunk) > 0 {
+		return send(chunk)
+	}
+
+	return nil
+}
+
+// insertFollowing adds the tuple (src, target) into followings, increments counters of both users
+// and emits the followed event. Counters of the users must be locked by lockCounters.
+// Conflicts are skipped instead of failing, so the transaction stays usable after ErrFollowing
+func insertFollowing(ctx context.Context, tx *sql.Tx, src, target int) error {
+	res, err := tx.ExecContext(
+		ctx,
+		`INSERT INTO followings(follower, followee, created_at) VALUES($1, $2, $3) ON CONFLICT DO NOTHING`,
+		src, target, time.Now(),
+	)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return storage.ErrFollowing
+	}
+
+	if err = addCounters(ctx, tx, src, target, 1); err != nil {
+		return err
+	}
+
+	return insertEvent(ctx, tx, models.FollowEventFollowed, src, target)
+}
+
+// removeFollowing deletes the tuple (src, target) from followings, decrements counters
+// of both users and emits the unfollowed event if the tuple existed. It reports whether the tuple existed.
+// Counters of the users must be locked by lockCounters
+func removeFollowing(ctx context.Context, tx *sql.Tx, src, target int) (bool, error) {
+	res, err := tx.ExecContext(ctx, `DELETE FROM followings WHERE follower=$1 AND followee=$2`, src, target)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	if affected == 0 {
+		return false, nil
+	}
+
+	if err = addCounters(ctx, tx, src, target, -1); err != nil {
+		return false, err
+	}
+
+	return true, insertEvent(ctx, tx, models.FollowEventUnfollowed, src, target)
+}
+
+// isUniqueViolation reports whether err is violation of the unique constraint
+func isUniqueViolation(err error) bool {
+	var pgerr *pgconn.PgError
+
+	return errors.As(err, &pgerr) && pgerr.Code == uniqueViolation
+}
+
+// withTx runs fn inside the transaction. The transaction is committed if fn succeeded,
+// otherwise it is rolled back
+func (s *Storage) withTx(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err = fn(tx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// listPage executes query selecting (id, uuid, created_at) rows and returns one page of followings.
+// One extra row is requested to find out whether the next page exists
+func (s *Storage) listPage(ctx context.Context, query string, uuid, after, limit int) ([]models.Following, int, error) {
+	rows, err := s.db.QueryContext(ctx, query, uuid, after, limit+1)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	list := make([]models.Following, 0, limit)
+	var (
+		temp      models.Following
+		createdAt sql.NullTime
+		next      int
+	)
+	for rows.Next() {
+		err = rows.Scan(&temp.ID, &temp.UUID, &createdAt)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if len(list) == limit {
+			next = list[len(list)-1].ID
+			break
+		}
+
+		temp.CreatedAt = createdAt.Time
+		list = append(list, temp)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	return list, next, nil
+}
+
+// descCursor converts zero cursor, which means the beginning of the list, to the cursor
+// pointing before all rows in descending order
+func descCursor(after int) int {
+	if after == 0 {
+		return math.MaxInt
+	}
+
+	return after
+}
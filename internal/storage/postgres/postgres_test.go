@@ -0,0 +1,386 @@
+package postgres_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/IlianBuh/Follow_Service/internal/domain/models"
+	"github.com/IlianBuh/Follow_Service/internal/migrator"
+	"github.com/IlianBuh/Follow_Service/internal/storage"
+	"github.com/IlianBuh/Follow_Service/internal/storage/postgres"
+	"github.com/stretchr/testify/require"
+	"io"
+	"log/slog"
+	"math/rand"
+	"os"
+	"sync"
+	"testing"
+	"time"
+)
+
+// urlEnv is the environment variable with url of the database used by the tests.
+// The tests are skipped if it is not set
+const urlEnv = "POSTGRES_TEST_URL"
+
+func TestFollowUnfollow(t *testing.T) {
+	ctx, st := newStorage(t)
+	src, target := randUUID(), randUUID()
+
+	state, err := st.Follow(ctx, src, target, 0)
+	require.NoError(t, err)
+	require.Equal(t, models.FollowStateFollowed, state)
+
+	_, err = st.Follow(ctx, src, target, 0)
+	require.ErrorIs(t, err, storage.ErrFollowing)
+
+	requireCounters(t, st, src, 0, 1)
+	requireCounters(t, st, target, 1, 0)
+
+	require.NoError(t, st.Unfollow(ctx, src, target))
+	require.ErrorIs(t, st.Unfollow(ctx, src, target), storage.ErrNoFollowing)
+
+	requireCounters(t, st, src, 0, 0)
+	requireCounters(t, st, target, 0, 0)
+}
+
+func TestListFollowersPaged(t *testing.T) {
+	ctx, st := newStorage(t)
+	uuid := randUUID()
+
+	followers := make([]int, 5)
+	for i := range followers {
+		followers[i] = randUUID()
+
+		_, err := st.Follow(ctx, followers[i], uuid, 0)
+		require.NoError(t, err)
+	}
+
+	for _, newestFirst := range []bool{false, true} {
+		listed := make([]int, 0, len(followers))
+		after := 0
+		for {
+			page, next, err := st.ListFollowers(ctx, uuid, after, 2, newestFirst)
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(page), 2)
+
+			for _, v := range page {
+				listed = append(listed, v.UUID)
+			}
+			if next == 0 {
+				break
+			}
+			after = next
+		}
+
+		expected := followers
+		if newestFirst {
+			expected = make([]int, len(followers))
+			for i, v := range followers {
+				expected[len(followers)-1-i] = v
+			}
+		}
+		require.Equal(t, expected, listed)
+	}
+}
+
+func TestFollowRequest(t *testing.T) {
+	ctx, st := newStorage(t)
+	src, target := randUUID(), randUUID()
+
+	require.NoError(t, st.SetPrivate(ctx, target, true, 0))
+	require.NoError(t, st.SetPrivate(ctx, target, true, 0))
+
+	state, err := st.Follow(ctx, src, target, 0)
+	require.NoError(t, err)
+	require.Equal(t, models.FollowStateRequested, state)
+
+	_, err = st.Follow(ctx, src, target, 0)
+	require.ErrorIs(t, err, storage.ErrRequested)
+	requireCounters(t, st, target, 0, 0)
+
+	require.NoError(t, st.ApproveFollowRequest(ctx, target, src, 0))
+	requireCounters(t, st, target, 1, 0)
+}
+
+func TestFolloweesLimit(t *testing.T) {
+	const (
+		workers      = 8
+		maxFollowees = 5
+	)
+
+	ctx, st := newStorage(t)
+	src := randUUID()
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		followed int
+	)
+	errs := make(chan error, workers)
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			_, err := st.Follow(ctx, src, randUUID(), maxFollowees)
+			switch {
+			case err == nil:
+				mu.Lock()
+				followed++
+				mu.Unlock()
+			case !errors.Is(err, storage.ErrFolloweesLimit):
+				errs <- err
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	require.Equal(t, maxFollowees, followed)
+	requireCounters(t, st, src, 0, maxFollowees)
+
+	_, err := st.BulkFollow(ctx, src, []int{randUUID()}, maxFollowees)
+	require.ErrorIs(t, err, storage.ErrFolloweesLimit)
+
+	target := randUUID()
+	require.NoError(t, st.SetPrivate(ctx, target, true, 0))
+	_, err = st.Follow(ctx, src, target, 0)
+	require.NoError(t, err)
+	require.ErrorIs(t, st.ApproveFollowRequest(ctx, target, src, maxFollowees), storage.ErrFolloweesLimit)
+	require.NoError(t, st.ApproveFollowRequest(ctx, target, src, 0))
+}
+
+func TestFollowCycleDoesNotDeadlock(t *testing.T) {
+	const rounds = 20
+
+	ctx, st := newStorage(t)
+
+	for range rounds {
+		users := []int{randUUID(), randUUID(), randUUID()}
+
+		// follows 0->1, 1->2 and 2->0 lock counters of overlapping users
+		var wg sync.WaitGroup
+		errs := make(chan error, len(users))
+		for i, src := range users {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				_, err := st.Follow(ctx, src, users[(i+1)%len(users)], 10)
+				errs <- err
+			}()
+		}
+		wg.Wait()
+		close(errs)
+
+		for err := range errs {
+			require.NoError(t, err)
+		}
+		for _, uuid := range users {
+			requireCounters(t, st, uuid, 1, 1)
+		}
+	}
+}
+
+func TestSetPublicApprovesRequests(t *testing.T) {
+	const maxFollowees = 1
+
+	ctx, st := newStorage(t)
+	uuid, requesters := randUUID(), []int{randUUID(), randUUID(), randUUID()}
+	require.NoError(t, st.SetPrivate(ctx, uuid, true, 0))
+
+	for _, requester := range requesters {
+		state, err := st.Follow(ctx, requester, uuid, 0)
+		require.NoError(t, err)
+		require.Equal(t, models.FollowStateRequested, state)
+	}
+
+	// the last requester already follows the maximum number of users
+	_, err := st.Follow(ctx, requesters[2], randUUID(), 0)
+	require.NoError(t, err)
+
+	require.NoError(t, st.SetPrivate(ctx, uuid, false, maxFollowees))
+
+	requests, _, err := st.ListFollowRequests(ctx, uuid, 0, 10)
+	require.NoError(t, err)
+	require.Empty(t, requests)
+
+	followers, _, err := st.ListFollowers(ctx, uuid, 0, 10, false)
+	require.NoError(t, err)
+	require.Len(t, followers, 2)
+	require.Equal(t, requesters[0], followers[0].UUID)
+	require.Equal(t, requesters[1], followers[1].UUID)
+
+	requireCounters(t, st, uuid, 2, 0)
+	requireCounters(t, st, requesters[0], 0, 1)
+	requireCounters(t, st, requesters[2], 0, 1)
+}
+
+func TestBlockAndMuteConflicts(t *testing.T) {
+	ctx, st := newStorage(t)
+	src, target := randUUID(), randUUID()
+
+	_, err := st.Follow(ctx, target, src, 0)
+	require.NoError(t, err)
+
+	require.NoError(t, st.Block(ctx, src, target))
+	require.ErrorIs(t, st.Block(ctx, src, target), storage.ErrBlocking)
+	requireCounters(t, st, src, 0, 0)
+
+	_, err = st.Follow(ctx, target, src, 0)
+	require.ErrorIs(t, err, storage.ErrBlocked)
+
+	require.NoError(t, st.Mute(ctx, src, target))
+	require.ErrorIs(t, st.Mute(ctx, src, target), storage.ErrMuting)
+}
+
+func TestIdempotencyKeyConflict(t *testing.T) {
+	ctx, st := newStorage(t)
+
+	key := models.IdempotencyKey{
+		Key:         "test-" + time.Now().Format(time.RFC3339Nano),
+		Fingerprint: "fingerprint",
+		CreatedAt:   time.Now(),
+	}
+	expiredBefore := time.Now().Add(-time.Hour)
+
+	require.NoError(t, st.SaveIdempotencyKey(ctx, key, expiredBefore))
+	require.ErrorIs(t, st.SaveIdempotencyKey(ctx, key, expiredBefore), storage.ErrIdempotencyKeyExists)
+
+	saved, err := st.IdempotencyKey(ctx, key.Key, expiredBefore)
+	require.NoError(t, err)
+	require.Equal(t, key.Fingerprint, saved.Fingerprint)
+	require.Empty(t, saved.Status)
+}
+
+func TestClaimEventsSkipsLeased(t *testing.T) {
+	ctx, st := newStorage(t)
+
+	// events of other tests may be unpublished, so the claimed ones are filtered by the followee
+	followee := randUUID()
+	for range 3 {
+		_, err := st.Follow(ctx, randUUID(), followee, 0)
+		require.NoError(t, err)
+	}
+
+	owner, other := "owner-"+t.Name(), "other-"+t.Name()
+	leasedUntil := time.Now().Add(time.Minute)
+
+	claimed := claimedOf(t, st, owner, leasedUntil, followee)
+	require.Len(t, claimed, 3)
+	require.Empty(t, claimedOf(t, st, other, leasedUntil, followee))
+	require.Len(t, claimedOf(t, st, owner, leasedUntil, followee), 3)
+
+	require.NoError(t, st.MarkPublished(ctx, []int{claimed[0].ID, claimed[1].ID, claimed[2].ID}))
+	require.Empty(t, claimedOf(t, st, owner, leasedUntil, followee))
+}
+
+func TestSequenceEventsInCommitOrder(t *testing.T) {
+	ctx, st := newStorage(t)
+	followee := randUUID()
+
+	db, err := sql.Open("pgx", os.Getenv(urlEnv))
+	require.NoError(t, err)
+	defer db.Close()
+
+	// the event with the smaller id is committed after the one with the greater id
+	late, err := db.BeginTx(ctx, nil)
+	require.NoError(t, err)
+	defer late.Rollback()
+	_, err = late.ExecContext(
+		ctx,
+		`INSERT INTO outbox(type, follower, followee, created_at) VALUES($1, $2, $3, $4)`,
+		string(models.FollowEventFollowed), randUUID(), followee, time.Now(),
+	)
+	require.NoError(t, err)
+
+	_, err = st.Follow(ctx, randUUID(), followee, 0)
+	require.NoError(t, err)
+	require.NoError(t, st.SequenceEvents(ctx))
+
+	events, err := st.EventsAfter(ctx, 0, followee, 10)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	after := events[0].Seq
+
+	require.NoError(t, late.Commit())
+	require.NoError(t, st.SequenceEvents(ctx))
+
+	events, err = st.EventsAfter(ctx, after, followee, 10)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Greater(t, events[0].Seq, after)
+
+	last, err := st.LastEventSeq(ctx)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, last, events[0].Seq)
+}
+
+// claimedOf claims all unpublished events and returns the ones about followings of the followee
+func claimedOf(
+	t *testing.T,
+	st *postgres.Storage,
+	owner string,
+	leasedUntil time.Time,
+	followee int,
+) []models.FollowEvent {
+	t.Helper()
+
+	events, err := st.ClaimEvents(context.Background(), owner, leasedUntil, 1<<20)
+	require.NoError(t, err)
+
+	res := make([]models.FollowEvent, 0, len(events))
+	for _, v := range events {
+		if v.Followee == followee {
+			res = append(res, v)
+		}
+	}
+
+	return res
+}
+
+// newStorage returns storage connected to the test database with all migrations applied
+func newStorage(t *testing.T) (context.Context, *postgres.Storage) {
+	t.Helper()
+
+	url := os.Getenv(urlEnv)
+	if url == "" {
+		t.Skipf("%s is not set", urlEnv)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	m, err := migrator.New(slog.New(slog.NewTextHandler(io.Discard, nil)), "postgres", url)
+	require.NoError(t, err)
+	defer m.Close()
+
+	_, err = m.Up(ctx)
+	require.NoError(t, err)
+
+	st, err := postgres.New(url)
+	require.NoError(t, err)
+
+	return ctx, st
+}
+
+// requireCounters checks the counters of the user
+func requireCounters(t *testing.T, st *postgres.Storage, uuid, followers, followees int) {
+	t.Helper()
+
+	cnt, err := st.CountFollowers(context.Background(), uuid)
+	require.NoError(t, err)
+	require.Equal(t, followers, cnt)
+
+	cnt, err = st.CountFollowees(context.Background(), uuid)
+	require.NoError(t, err)
+	require.Equal(t, followees, cnt)
+}
+
+// randUUID returns random user id, the database is shared between runs so ids must not repeat
+func randUUID() int {
+	return 1 + rand.Intn(1<<31-2)
+}
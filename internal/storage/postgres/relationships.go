@@ -0,0 +1,59 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"github.com/IlianBuh/Follow_Service/internal/domain/models"
+)
+
+// Relationships returns relationship between the viewer and every target in order of targets.
+// All followings are fetched by one query using unique (follower, followee) index
+func (s *Storage) Relationships(ctx context.Context, viewer int, targets []int) ([]models.Relationship, error) {
+	const op = "postgres.Relationships"
+
+	if len(targets) == 0 {
+		return []models.Relationship{}, nil
+	}
+
+	rows, err := s.db.QueryContext(
+		ctx,
+		`SELECT follower, followee FROM followings
+			WHERE (follower=$1 AND followee=ANY($2)) OR (followee=$1 AND follower=ANY($2))`,
+		viewer, targets,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	following := make(map[int]bool)
+	followedBy := make(map[int]bool)
+	var follower, followee int
+	for rows.Next() {
+		err = rows.Scan(&follower, &followee)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		if follower == viewer {
+			following[followee] = true
+		}
+		if followee == viewer {
+			followedBy[follower] = true
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	res := make([]models.Relationship, len(targets))
+	for i, v := range targets {
+		res[i] = models.Relationship{
+			UUID:       v,
+			Following:  following[v],
+			FollowedBy: followedBy[v],
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,265 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/IlianBuh/Follow_Service/internal/domain/models"
+	"github.com/IlianBuh/Follow_Service/internal/storage"
+	"time"
+)
+
+// SetPrivate sets privacy of the account with uuid. Making the account public approves all
+// pending follow requests to it in the same transaction. Requests of the users who already
+// follow 'maxFollowees' users are removed instead, zero means no limit
+func (s *Storage) SetPrivate(ctx context.Context, uuid int, private bool, maxFollowees int) error {
+	const op = "postgres.SetPrivate"
+
+	err := s.withTx(ctx, func(tx *sql.Tx) error {
+		// the settings row stays locked, so follows to the user wait for the transaction
+		// instead of creating requests to the public account
+		_, err := tx.ExecContext(
+			ctx,
+			`INSERT INTO account_settings(uuid, private) VALUES($1, $2)
+				ON CONFLICT(uuid) DO UPDATE SET private=excluded.private`,
+			uuid, private,
+		)
+		if err != nil || private {
+			return err
+		}
+
+		requesters, err := deleteRequests(ctx, tx, uuid)
+		if err != nil {
+			return err
+		}
+
+		if err = lockCounters(ctx, tx, append([]int{uuid}, requesters...)...); err != nil {
+			return err
+		}
+
+		for _, requester := range requesters {
+			err = checkFolloweesLimit(ctx, tx, requester, 1, maxFollowees)
+			if err == nil {
+				err = insertFollowing(ctx, tx, requester, uuid)
+			}
+			if err != nil && !errors.Is(err, storage.ErrFolloweesLimit) && !errors.Is(err, storage.ErrFollowing) {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// IsPrivate reports whether the account with uuid is private. Accounts are public by default
+func (s *Storage) IsPrivate(ctx context.Context, uuid int) (bool, error) {
+	const op = "postgres.IsPrivate"
+
+	private, err := isPrivate(ctx, s.db, uuid)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return private, nil
+}
+
+// ListFollowRequests returns at most 'limit' pending follow requests to the user with uuid ordered
+// by id. Only requests with id greater than 'after' are returned. The second value is the id of
+// the last returned request if there are more requests to list, otherwise zero
+func (s *Storage) ListFollowRequests(ctx context.Context, uuid, after, limit int) ([]models.Following, int, error) {
+	const op = "postgres.ListFollowRequests"
+
+	list, next, err := s.listPage(
+		ctx,
+		`SELECT id, follower, created_at FROM follow_requests WHERE followee=$1 AND id>$2 ORDER BY id LIMIT $3`,
+		uuid, after, limit,
+	)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return list, next, nil
+}
+
+// ApproveFollowRequest removes the follow request of the requester to the user with uuid
+// and creates the following instead. ErrFolloweesLimit is returned if the requester already
+// follows 'maxFollowees' users, zero means no limit
+func (s *Storage) ApproveFollowRequest(ctx context.Context, uuid, requester, maxFollowees int) error {
+	const op = "postgres.ApproveFollowRequest"
+
+	err := s.withTx(ctx, func(tx *sql.Tx) error {
+		err := lockPair(ctx, tx, requester, uuid)
+		if err != nil {
+			return err
+		}
+
+		if err = deleteRequest(ctx, tx, requester, uuid); err != nil {
+			return err
+		}
+
+		if err = lockCounters(ctx, tx, requester, uuid); err != nil {
+			return err
+		}
+
+		if err = checkFolloweesLimit(ctx, tx, requester, 1, maxFollowees); err != nil {
+			return err
+		}
+
+		err = insertFollowing(ctx, tx, requester, uuid)
+		if errors.Is(err, storage.ErrFollowing) {
+			return nil
+		}
+
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// RejectFollowRequest removes the follow request of the requester to the user with uuid
+func (s *Storage) RejectFollowRequest(ctx context.Context, uuid, requester int) error {
+	const op = "postgres.RejectFollowRequest"
+
+	err := s.withTx(ctx, func(tx *sql.Tx) error {
+		return deleteRequest(ctx, tx, requester, uuid)
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// querier is implemented by both database and transaction
+type querier interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// deleteRequests removes all follow requests to the user with uuid and returns the requesters
+// in order of requests
+func deleteRequests(ctx context.Context, tx *sql.Tx, uuid int) ([]int, error) {
+	rows, err := tx.QueryContext(
+		ctx,
+		`WITH deleted AS (DELETE FROM follow_requests WHERE followee=$1 RETURNING id, follower)
+			SELECT follower FROM deleted ORDER BY id`,
+		uuid,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var requesters []int
+	for rows.Next() {
+		var requester int
+		if err = rows.Scan(&requester); err != nil {
+			return nil, err
+		}
+
+		requesters = append(requesters, requester)
+	}
+
+	return requesters, rows.Err()
+}
+
+// lockPrivacy reports whether the account with uuid is private and locks its settings until
+// the end of the transaction, so the privacy can't be changed concurrently. Settings must be
+// locked before counters, as SetPrivate locks counters while holding the settings
+func lockPrivacy(ctx context.Context, tx *sql.Tx, uuid int) (bool, error) {
+	var private bool
+
+	err := tx.QueryRowContext(
+		ctx,
+		`SELECT private FROM account_settings WHERE uuid=$1 FOR SHARE`,
+		uuid,
+	).Scan(&private)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return private, nil
+}
+
+// isPrivate reports whether the account with uuid is private
+func isPrivate(ctx context.Context, q querier, uuid int) (bool, error) {
+	var private bool
+
+	err := q.QueryRowContext(ctx, `SELECT private FROM account_settings WHERE uuid=$1`, uuid).Scan(&private)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return private, nil
+}
+
+// insertRequest adds the follow request (src, target). Returns storage.ErrFollowing if src
+// already follows target and storage.ErrRequested if the request already exists
+func insertRequest(ctx context.Context, tx *sql.Tx, src, target int) error {
+	var following bool
+
+	err := tx.QueryRowContext(
+		ctx,
+		`SELECT EXISTS(SELECT 1 FROM followings WHERE follower=$1 AND followee=$2)`,
+		src, target,
+	).Scan(&following)
+	if err != nil {
+		return err
+	}
+	if following {
+		return storage.ErrFollowing
+	}
+
+	res, err := tx.ExecContext(
+		ctx,
+		`INSERT INTO follow_requests(follower, followee, created_at) VALUES($1, $2, $3) ON CONFLICT DO NOTHING`,
+		src, target, time.Now(),
+	)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return storage.ErrRequested
+	}
+
+	return nil
+}
+
+// deleteRequest removes the follow request (src, target). Returns storage.ErrNoRequest if it does not exist
+func deleteRequest(ctx context.Context, tx *sql.Tx, src, target int) error {
+	res, err := tx.ExecContext(ctx, `DELETE FROM follow_requests WHERE follower=$1 AND followee=$2`, src, target)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return storage.ErrNoRequest
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package postgres
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"github.com/IlianBuh/Follow_Service/internal/domain/models"
+	"slices"
+	"time"
+)
+
+// SaveRetry stores the delivery which has to be attempted again
+func (s *Storage) SaveRetry(ctx context.Context, delivery models.Delivery) error {
+	const op = "postgres.SaveRetry"
+
+	_, err := s.db.ExecContext(
+		ctx,
+		`INSERT INTO webhook_retries(event_id, endpoint, payload, error, attempts, next_attempt_at, created_at)
+			VALUES($1, $2, $3, $4, $5, $6, $7)`,
+		delivery.EventID, delivery.Endpoint, string(delivery.Payload), delivery.Error,
+		delivery.Attempts, delivery.NextAttemptAt, time.Now(),
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// ClaimRetries returns at most 'limit' deliveries which are due in order they were stored.
+// The next attempt of returned deliveries is postponed to 'leasedUntil', so they are not
+// claimed again until then. Rows being claimed concurrently are skipped
+func (s *Storage) ClaimRetries(ctx context.Context, leasedUntil time.Time, limit int) ([]models.Delivery, error) {
+	const op = "postgres.ClaimRetries"
+
+	rows, err := s.db.QueryContext(
+		ctx,
+		`UPDATE webhook_retries SET next_attempt_at=$1 WHERE id IN (
+			SELECT id FROM webhook_retries WHERE next_attempt_at<=$2
+				ORDER BY id LIMIT $3 FOR UPDATE SKIP LOCKED
+		) RETURNING id, event_id, endpoint, payload, error, attempts, next_attempt_at`,
+		leasedUntil, time.Now(), limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	deliveries := make([]models.Delivery, 0, limit)
+	var (
+		temp    models.Delivery
+		payload string
+	)
+	for rows.Next() {
+		err = rows.Scan(
+			&temp.ID, &temp.EventID, &temp.Endpoint, &payload,
+			&temp.Error, &temp.Attempts, &temp.NextAttemptAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		temp.Payload = []byte(payload)
+		deliveries = append(deliveries, temp)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	slices.SortFunc(deliveries, func(a, b models.Delivery) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	return deliveries, nil
+}
+
+// UpdateRetry stores the error, the number of attempts and the time of the next attempt of the delivery
+func (s *Storage) UpdateRetry(ctx context.Context, delivery models.Delivery) error {
+	const op = "postgres.UpdateRetry"
+
+	_, err := s.db.ExecContext(
+		ctx,
+		`UPDATE webhook_retries SET error=$1, attempts=$2, next_attempt_at=$3 WHERE id=$4`,
+		delivery.Error, delivery.Attempts, delivery.NextAttemptAt, delivery.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// DeleteRetry deletes the delivery which is not attempted anymore
+func (s *Storage) DeleteRetry(ctx context.Context, id int) error {
+	const op = "postgres.DeleteRetry"
+
+	if _, err := s.db.ExecContext(ctx, `DELETE FROM webhook_retries WHERE id=$1`, id); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/IlianBuh/Follow_Service/internal/storage"
+	"time"
+)
+
+// Block adds the tuple (src, target) into blocks and removes followings and
+// follow requests between the users in both directions
+func (s *Storage) Block(ctx context.Context, src, target int) error {
+	const op = "sqlite.Block"
+
+	err := s.withTx(ctx, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(
+			ctx,
+			`INSERT INTO blocks(blocker, blocked, created_at) VALUES(?, ?, ?)`,
+			src, target, time.Now().Unix(),
+		)
+		if err != nil {
+			if isUniqueViolation(err) {
+				return storage.ErrBlocking
+			}
+
+			return err
+		}
+
+		_, err = tx.ExecContext(
+			ctx,
+			`DELETE FROM follow_requests WHERE (follower=? AND followee=?) OR (follower=? AND followee=?)`,
+			src, target, target, src,
+		)
+		if err != nil {
+			return err
+		}
+
+		if _, err = removeFollowing(ctx, tx, src, target); err != nil {
+			return err
+		}
+
+		_, err = removeFollowing(ctx, tx, target, src)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// Unblock deletes the tuple (src, target) from blocks
+func (s *Storage) Unblock(ctx context.Context, src, target int) error {
+	const op = "sqlite.Unblock"
+
+	res, err := s.db.ExecContext(ctx, `DELETE FROM blocks WHERE blocker=? AND blocked=?`, src, target)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrNoBlocking)
+	}
+
+	return nil
+}
+
+// ListBlocked returns at most 'limit' users blocked by the user with uuid ordered by id. Only blocks
+// with id greater than 'after' are returned. The second value is the id of the last returned
+// block if there are more blocked users to list, otherwise zero
+func (s *Storage) ListBlocked(ctx context.Context, uuid, after, limit int) ([]int, int, error) {
+	const op = "sqlite.ListBlocked"
+
+	list, next, err := s.listPage(
+		ctx,
+		`SELECT id, blocked, created_at FROM blocks WHERE blocker=? AND id>? ORDER BY id LIMIT ?`,
+		uuid, after, limit,
+	)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	uuids := make([]int, len(list))
+	for i, v := range list {
+		uuids[i] = v.UUID
+	}
+
+	return uuids, next, nil
+}
+
+// checkNotBlocked returns storage.ErrBlocked if any of the users has blocked the other one
+func checkNotBlocked(ctx context.Context, tx *sql.Tx, src, target int) error {
+	var blocked bool
+
+	err := tx.QueryRowContext(
+		ctx,
+		`SELECT EXISTS(
+			SELECT 1 FROM blocks WHERE (blocker=? AND blocked=?) OR (blocker=? AND blocked=?)
+		)`,
+		src, target, target, src,
+	).Scan(&blocked)
+	if err != nil {
+		return err
+	}
+	if blocked {
+		return storage.ErrBlocked
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/IlianBuh/Follow_Service/internal/domain/models"
+	"github.com/IlianBuh/Follow_Service/internal/storage"
+)
+
+// BulkFollow follows user src on every target in one transaction and returns the result
+// for every target in order of targets. Private targets get follow requests instead.
+// ErrFolloweesLimit is returned if following all targets could make src follow more than
+// 'maxFollowees' users, zero means no limit
+func (s *Storage) BulkFollow(
+	ctx context.Context,
+	src int,
+	targets []int,
+	maxFollowees int,
+) ([]models.BulkResult, error) {
+	const op = "sqlite.BulkFollow"
+
+	res := make([]models.BulkResult, len(targets))
+	err := s.withTx(ctx, func(tx *sql.Tx) error {
+		err := checkFolloweesLimit(ctx, tx, src, len(targets), maxFollowees)
+		if err != nil {
+			return err
+		}
+
+		for i, target := range targets {
+			status, err := followOne(ctx, tx, src, target)
+			if err != nil {
+				return err
+			}
+
+			res[i] = models.BulkResult{UUID: target, Status: status}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return res, nil
+}
+
+// BulkUnfollow unfollows user src from every target in one transaction and returns
+// the result for every target in order of targets
+func (s *Storage) BulkUnfollow(ctx context.Context, src int, targets []int) ([]models.BulkResult, error) {
+	const op = "sqlite.BulkUnfollow"
+
+	res := make([]models.BulkResult, len(targets))
+	err := s.withTx(ctx, func(tx *sql.Tx) error {
+		for i, target := range targets {
+			removed, err := removeFollowing(ctx, tx, src, target)
+			if err != nil {
+				return err
+			}
+
+			res[i] = models.BulkResult{UUID: target, Status: models.BulkStatusNotFollowing}
+			if removed {
+				res[i].Status = models.BulkStatusUnfollowed
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return res, nil
+}
+
+// followOne follows user src on target inside the transaction. Expected failures
+// are converted to the bulk status, only unexpected ones are returned as errors
+func followOne(ctx context.Context, tx *sql.Tx, src, target int) (models.BulkStatus, error) {
+	err := checkNotBlocked(ctx, tx, src, target)
+	if err != nil {
+		if errors.Is(err, storage.ErrBlocked) {
+			return models.BulkStatusBlocked, nil
+		}
+
+		return 0, err
+	}
+
+	private, err := isPrivate(ctx, tx, target)
+	if err != nil {
+		return 0, err
+	}
+
+	status := models.BulkStatusFollowed
+	if private {
+		status = models.BulkStatusRequested
+		err = insertRequest(ctx, tx, src, target)
+	} else {
+		err = insertFollowing(ctx, tx, src, target)
+	}
+
+	switch {
+	case errors.Is(err, storage.ErrFollowing):
+		return models.BulkStatusAlreadyFollowing, nil
+	case errors.Is(err, storage.ErrRequested):
+		return models.BulkStatusAlreadyRequested, nil
+	case err != nil:
+		return 0, err
+	}
+
+	return status, nil
+}
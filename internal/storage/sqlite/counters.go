@@ -0,0 +1,90 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/IlianBuh/Follow_Service/internal/storage"
+)
+
+// CountFollowers returns number of followers of the user with uuid
+func (s *Storage) CountFollowers(ctx context.Context, uuid int) (int, error) {
+	const op = "sqlite.CountFollowers"
+
+	count, err := s.counter(ctx, `SELECT followers FROM follow_counters WHERE uuid=?`, uuid)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return count, nil
+}
+
+// CountFollowees returns number of followees of the user with uuid
+func (s *Storage) CountFollowees(ctx context.Context, uuid int) (int, error) {
+	const op = "sqlite.CountFollowees"
+
+	count, err := s.counter(ctx, `SELECT followees FROM follow_counters WHERE uuid=?`, uuid)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return count, nil
+}
+
+// counter executes query selecting one counter of the user. User without counters row has zero counter
+func (s *Storage) counter(ctx context.Context, query string, uuid int) (int, error) {
+	var count int
+
+	err := s.db.QueryRowContext(ctx, query, uuid).Scan(&count)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, nil
+		}
+
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// addCounters adds delta to the followees counter of src and to the followers counter of target
+func addCounters(ctx context.Context, tx *sql.Tx, src, target, delta int) error {
+	_, err := tx.ExecContext(
+		ctx,
+		`INSERT INTO follow_counters(uuid, followees) VALUES(?, ?)
+			ON CONFLICT(uuid) DO UPDATE SET followees=followees+excluded.followees`,
+		src, delta,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(
+		ctx,
+		`INSERT INTO follow_counters(uuid, followers) VALUES(?, ?)
+			ON CONFLICT(uuid) DO UPDATE SET followers=followers+excluded.followers`,
+		target, delta,
+	)
+
+	return err
+}
+
+// checkFolloweesLimit returns storage.ErrFolloweesLimit if following 'n' more users by the user
+// exceeds 'limit'. Zero limit means no limit
+func checkFolloweesLimit(ctx context.Context, tx *sql.Tx, uuid, n, limit int) error {
+	if limit <= 0 {
+		return nil
+	}
+
+	var followees int
+	err := tx.QueryRowContext(ctx, `SELECT followees FROM follow_counters WHERE uuid=?`, uuid).Scan(&followees)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+	if followees+n > limit {
+		return storage.ErrFolloweesLimit
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/IlianBuh/Follow_Service/internal/domain/models"
+	"github.com/IlianBuh/Follow_Service/internal/storage"
+	"time"
+)
+
+// SaveIdempotencyKey stores the outcome of the request made with the idempotency key.
+// Keys created before 'expiredBefore' are removed first, so expired keys may be reused.
+// ErrIdempotencyKeyExists is returned if the key is already stored
+func (s *Storage) SaveIdempotencyKey(ctx context.Context, key models.IdempotencyKey, expiredBefore time.Time) error {
+	const op = "sqlite.SaveIdempotencyKey"
+
+	err := s.withTx(ctx, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `DELETE FROM idempotency_keys WHERE created_at<?`, expiredBefore.Unix())
+		if err != nil {
+			return err
+		}
+
+		res, err := tx.ExecContext(
+			ctx,
+			`INSERT INTO idempotency_keys(idempotency_key, fingerprint, status, response, created_at)
+				VALUES(?, ?, ?, ?, ?) ON CONFLICT DO NOTHING`,
+			key.Key, key.Fingerprint, key.Status, key.Response, key.CreatedAt.Unix(),
+		)
+		if err != nil {
+			return err
+		}
+
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if affected == 0 {
+			return storage.ErrIdempotencyKeyExists
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// IdempotencyKey returns the stored outcome of the request made with the key.
+// ErrIdempotencyKeyNotFound is returned if there is no such key created since 'expiredBefore'
+func (s *Storage) IdempotencyKey(ctx context.Context, key string, expiredBefore time.Time) (models.IdempotencyKey, error) {
+	const op = "sqlite.IdempotencyKey"
+
+	var (
+		res       models.IdempotencyKey
+		createdAt int64
+	)
+	err := s.db.QueryRowContext(
+		ctx,
+		`SELECT idempotency_key, fingerprint, status, response, created_at FROM idempotency_keys
+			WHERE idempotency_key=? AND created_at>=?`,
+		key, expiredBefore.Unix(),
+	).Scan(&res.Key, &res.Fingerprint, &res.Status, &res.Response, &createdAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.IdempotencyKey{}, fmt.Errorf("%s: %w", op, storage.ErrIdempotencyKeyNotFound)
+		}
+
+		return models.IdempotencyKey{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	res.CreatedAt = time.Unix(createdAt, 0)
+	return res, nil
+}
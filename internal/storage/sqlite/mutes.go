@@ -0,0 +1,49 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"github.com/IlianBuh/Follow_Service/internal/storage"
+	"time"
+)
+
+// Mute adds the tuple (src, target) into mutes. Muted followees are still followed
+// but may be excluded from the list of followees
+func (s *Storage) Mute(ctx context.Context, src, target int) error {
+	const op = "sqlite.Mute"
+
+	_, err := s.db.ExecContext(
+		ctx,
+		`INSERT INTO mutes(muter, muted, created_at) VALUES(?, ?, ?)`,
+		src, target, time.Now().Unix(),
+	)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return fmt.Errorf("%s: %w", op, storage.ErrMuting)
+		}
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// Unmute deletes the tuple (src, target) from mutes
+func (s *Storage) Unmute(ctx context.Context, src, target int) error {
+	const op = "sqlite.Unmute"
+
+	res, err := s.db.ExecContext(ctx, `DELETE FROM mutes WHERE muter=? AND muted=?`, src, target)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("%s: %w", op, storage.ErrNoMuting)
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"github.com/IlianBuh/Follow_Service/internal/domain/models"
+)
+
+// ListMutuals returns at most 'limit' users who both follow and are followed by the user
+// with uuid, ordered by id of the following of the user. Only mutuals after the following
+// with id 'after' are returned, zero 'after' means the beginning of the list. The second value
+// is the id of the last returned following if there are more mutuals to list, otherwise zero
+func (s *Storage) ListMutuals(ctx context.Context, uuid, after, limit int) ([]models.Following, int, error) {
+	const op = "sqlite.ListMutuals"
+
+	list, next, err := s.listPage(
+		ctx,
+		`SELECT f.id, f.followee, f.created_at FROM followings f
+			JOIN followings b ON b.follower=f.followee AND b.followee=f.follower
+			WHERE f.follower=? AND f.id>? ORDER BY f.id LIMIT ?`,
+		uuid, after, limit,
+	)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return list, next, nil
+}
+
+// CountMutuals returns number of users who both follow and are followed by the user with uuid
+func (s *Storage) CountMutuals(ctx context.Context, uuid int) (int, error) {
+	const op = "sqlite.CountMutuals"
+
+	count, err := s.counter(
+		ctx,
+		`SELECT COUNT(*) FROM followings f
+			JOIN followings b ON b.follower=f.followee AND b.followee=f.follower
+			WHERE f.follower=?`,
+		uuid,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return count, nil
+}
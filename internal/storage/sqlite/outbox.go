@@ -0,0 +1,200 @@
+package sqlite
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/IlianBuh/Follow_Service/internal/domain/models"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ClaimEvents leases at most 'limit' unpublished events to the owner until 'leasedUntil' and
+// returns them in order they were emitted. Events leased by another owner are skipped until
+// the lease expires, events leased by the owner are returned again
+func (s *Storage) ClaimEvents(
+	ctx context.Context,
+	owner string,
+	leasedUntil time.Time,
+	limit int,
+) ([]models.FollowEvent, error) {
+	const op = "sqlite.ClaimEvents"
+
+	rows, err := s.db.QueryContext(
+		ctx,
+		`UPDATE outbox SET lease_owner=?, leased_until=? WHERE id IN (
+			SELECT id FROM outbox
+				WHERE published_at IS NULL AND (leased_until IS NULL OR leased_until<? OR lease_owner=?)
+				ORDER BY id LIMIT ?
+		) RETURNING id, COALESCE(seq, 0), type, follower, followee, created_at`,
+		owner, leasedUntil.Unix(), time.Now().Unix(), owner, limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	events, err := scanEvents(rows, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	slices.SortFunc(events, func(a, b models.FollowEvent) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	return events, nil
+}
+
+// MarkPublished marks events with ids as published
+func (s *Storage) MarkPublished(ctx context.Context, ids []int) error {
+	const op = "sqlite.MarkPublished"
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	args := make([]any, 0, len(ids)+1)
+	args = append(args, time.Now().Unix())
+	for _, v := range ids {
+		args = append(args, v)
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(ids)), ",")
+	_, err := s.db.ExecContext(
+		ctx,
+		fmt.Sprintf(`UPDATE outbox SET published_at=? WHERE id IN (%s)`, placeholders),
+		args...,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// SequenceEvents assigns sequence numbers to the events which do not have them yet.
+// Write transactions are serialized, so events are committed in order of their ids
+// and the ids are used as the numbers
+func (s *Storage) SequenceEvents(ctx context.Context) error {
+	const op = "sqlite.SequenceEvents"
+
+	// the write lock is not taken while there is nothing to sequence
+	var unsequenced bool
+	err := s.db.QueryRowContext(ctx, `SELECT EXISTS(SELECT 1 FROM outbox WHERE seq IS NULL)`).Scan(&unsequenced)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if !unsequenced {
+		return nil
+	}
+
+	_, err = s.db.ExecContext(ctx, `UPDATE outbox SET seq=id WHERE seq IS NULL`)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// DeletePublishedEvents deletes at most 'limit' sequenced events published before the moment
+// and returns the number of deleted events
+func (s *Storage) DeletePublishedEvents(ctx context.Context, before time.Time, limit int) (int, error) {
+	const op = "sqlite.DeletePublishedEvents"
+
+	res, err := s.db.ExecContext(
+		ctx,
+		`DELETE FROM outbox WHERE id IN (
+			SELECT id FROM outbox WHERE published_at<? AND seq IS NOT NULL LIMIT ?
+		)`,
+		before.Unix(), limit,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return int(deleted), nil
+}
+
+// EventsAfter returns at most 'limit' sequenced events with sequence number greater than 'after'
+// in order they were committed. Only events where the user with uuid is the follower or the followee
+// are returned, zero uuid means events of all users
+func (s *Storage) EventsAfter(ctx context.Context, after, uuid, limit int) ([]models.FollowEvent, error) {
+	const op = "sqlite.EventsAfter"
+
+	query, args := `SELECT id, seq, type, follower, followee, created_at FROM outbox
+		WHERE seq>? ORDER BY seq LIMIT ?`, []any{after, limit}
+	if uuid != 0 {
+		query, args = `SELECT id, seq, type, follower, followee, created_at FROM outbox
+			WHERE seq>? AND (follower=? OR followee=?) ORDER BY seq LIMIT ?`, []any{after, uuid, uuid, limit}
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	events, err := scanEvents(rows, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return events, nil
+}
+
+// LastEventSeq returns sequence number of the last sequenced event or zero if there are no such events
+func (s *Storage) LastEventSeq(ctx context.Context) (int, error) {
+	const op = "sqlite.LastEventSeq"
+
+	var seq int
+	err := s.db.QueryRowContext(ctx, `SELECT COALESCE(MAX(seq), 0) FROM outbox`).Scan(&seq)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return seq, nil
+}
+
+// insertEvent adds the event about the following (follower, followee) into the outbox
+func insertEvent(ctx context.Context, tx *sql.Tx, typ models.FollowEventType, follower, followee int) error {
+	_, err := tx.ExecContext(
+		ctx,
+		`INSERT INTO outbox(type, follower, followee, created_at) VALUES(?, ?, ?, ?)`,
+		string(typ), follower, followee, time.Now().Unix(),
+	)
+
+	return err
+}
+
+// scanEvents reads (id, seq, type, follower, followee, created_at) rows of the outbox
+func scanEvents(rows *sql.Rows, size int) ([]models.FollowEvent, error) {
+	events := make([]models.FollowEvent, 0, size)
+	var (
+		temp      models.FollowEvent
+		typ       string
+		createdAt int64
+	)
+	for rows.Next() {
+		err := rows.Scan(&temp.ID, &temp.Seq, &typ, &temp.Follower, &temp.Followee, &createdAt)
+		if err != nil {
+			return nil, err
+		}
+
+		temp.Type = models.FollowEventType(typ)
+		temp.CreatedAt = time.Unix(createdAt, 0)
+		events = append(events, temp)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
@@ -0,0 +1,72 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"github.com/IlianBuh/Follow_Service/internal/domain/models"
+	"strings"
+)
+
+// Relationships returns relationship between the viewer and every target in order of targets.
+// All followings are fetched by one query using unique (follower, followee) index
+func (s *Storage) Relationships(ctx context.Context, viewer int, targets []int) ([]models.Relationship, error) {
+	const op = "sqlite.Relationships"
+
+	if len(targets) == 0 {
+		return []models.Relationship{}, nil
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(targets)), ",")
+	query := fmt.Sprintf(
+		`SELECT follower, followee FROM followings
+			WHERE (follower=? AND followee IN (%[1]s)) OR (followee=? AND follower IN (%[1]s))`,
+		placeholders,
+	)
+
+	args := make([]any, 0, 2*len(targets)+2)
+	args = append(args, viewer)
+	for _, v := range targets {
+		args = append(args, v)
+	}
+	args = append(args, viewer)
+	for _, v := range targets {
+		args = append(args, v)
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	following := make(map[int]bool)
+	followedBy := make(map[int]bool)
+	var follower, followee int
+	for rows.Next() {
+		err = rows.Scan(&follower, &followee)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		if follower == viewer {
+			following[followee] = true
+		}
+		if followee == viewer {
+			followedBy[follower] = true
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	res := make([]models.Relationship, len(targets))
+	for i, v := range targets {
+		res[i] = models.Relationship{
+			UUID:       v,
+			Following:  following[v],
+			FollowedBy: followedBy[v],
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,234 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/IlianBuh/Follow_Service/internal/domain/models"
+	"github.com/IlianBuh/Follow_Service/internal/storage"
+	"time"
+)
+
+// SetPrivate sets privacy of the account with uuid. Making the account public approves all
+// pending follow requests to it in the same transaction. Requests of the users who already
+// follow 'maxFollowees' users are removed instead, zero means no limit
+func (s *Storage) SetPrivate(ctx context.Context, uuid int, private bool, maxFollowees int) error {
+	const op = "sqlite.SetPrivate"
+
+	err := s.withTx(ctx, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(
+			ctx,
+			`INSERT INTO account_settings(uuid, private) VALUES(?, ?)
+				ON CONFLICT(uuid) DO UPDATE SET private=excluded.private`,
+			uuid, private,
+		)
+		if err != nil || private {
+			return err
+		}
+
+		requesters, err := deleteRequests(ctx, tx, uuid)
+		if err != nil {
+			return err
+		}
+
+		return approveRequests(ctx, tx, uuid, requesters, maxFollowees)
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// IsPrivate reports whether the account with uuid is private. Accounts are public by default
+func (s *Storage) IsPrivate(ctx context.Context, uuid int) (bool, error) {
+	const op = "sqlite.IsPrivate"
+
+	private, err := isPrivate(ctx, s.db, uuid)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return private, nil
+}
+
+// ListFollowRequests returns at most 'limit' pending follow requests to the user with uuid ordered
+// by id. Only requests with id greater than 'after' are returned. The second value is the id of
+// the last returned request if there are more requests to list, otherwise zero
+func (s *Storage) ListFollowRequests(ctx context.Context, uuid, after, limit int) ([]models.Following, int, error) {
+	const op = "sqlite.ListFollowRequests"
+
+	list, next, err := s.listPage(
+		ctx,
+		`SELECT id, follower, created_at FROM follow_requests WHERE followee=? AND id>? ORDER BY id LIMIT ?`,
+		uuid, after, limit,
+	)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return list, next, nil
+}
+
+// ApproveFollowRequest removes the follow request of the requester to the user with uuid
+// and creates the following instead. ErrFolloweesLimit is returned if the requester already
+// follows 'maxFollowees' users, zero means no limit
+func (s *Storage) ApproveFollowRequest(ctx context.Context, uuid, requester, maxFollowees int) error {
+	const op = "sqlite.ApproveFollowRequest"
+
+	err := s.withTx(ctx, func(tx *sql.Tx) error {
+		err := deleteRequest(ctx, tx, requester, uuid)
+		if err != nil {
+			return err
+		}
+
+		if err = checkFolloweesLimit(ctx, tx, requester, 1, maxFollowees); err != nil {
+			return err
+		}
+
+		err = insertFollowing(ctx, tx, requester, uuid)
+		if errors.Is(err, storage.ErrFollowing) {
+			return nil
+		}
+
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// RejectFollowRequest removes the follow request of the requester to the user with uuid
+func (s *Storage) RejectFollowRequest(ctx context.Context, uuid, requester int) error {
+	const op = "sqlite.RejectFollowRequest"
+
+	err := s.withTx(ctx, func(tx *sql.Tx) error {
+		return deleteRequest(ctx, tx, requester, uuid)
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// deleteRequests removes all follow requests to the user with uuid and returns the requesters
+// in order of requests
+func deleteRequests(ctx context.Context, tx *sql.Tx, uuid int) ([]int, error) {
+	rows, err := tx.QueryContext(ctx, `SELECT follower FROM follow_requests WHERE followee=? ORDER BY id`, uuid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var requesters []int
+	for rows.Next() {
+		var requester int
+		if err = rows.Scan(&requester); err != nil {
+			return nil, err
+		}
+
+		requesters = append(requesters, requester)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM follow_requests WHERE followee=?`, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	return requesters, nil
+}
+
+// approveRequests creates followings of every requester to the user with uuid. Requesters who
+// already follow 'maxFollowees' users or the user are skipped, zero means no limit
+func approveRequests(ctx context.Context, tx *sql.Tx, uuid int, requesters []int, maxFollowees int) error {
+	for _, requester := range requesters {
+		err := checkFolloweesLimit(ctx, tx, requester, 1, maxFollowees)
+		if err == nil {
+			err = insertFollowing(ctx, tx, requester, uuid)
+		}
+		if err != nil && !errors.Is(err, storage.ErrFolloweesLimit) && !errors.Is(err, storage.ErrFollowing) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// querier is implemented by both database and transaction
+type querier interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// isPrivate reports whether the account with uuid is private
+func isPrivate(ctx context.Context, q querier, uuid int) (bool, error) {
+	var private bool
+
+	err := q.QueryRowContext(ctx, `SELECT private FROM account_settings WHERE uuid=?`, uuid).Scan(&private)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return private, nil
+}
+
+// insertRequest adds the follow request (src, target). Returns storage.ErrFollowing if src
+// already follows target and storage.ErrRequested if the request already exists
+func insertRequest(ctx context.Context, tx *sql.Tx, src, target int) error {
+	var following bool
+
+	err := tx.QueryRowContext(
+		ctx,
+		`SELECT EXISTS(SELECT 1 FROM followings WHERE follower=? AND followee=?)`,
+		src, target,
+	).Scan(&following)
+	if err != nil {
+		return err
+	}
+	if following {
+		return storage.ErrFollowing
+	}
+
+	_, err = tx.ExecContext(
+		ctx,
+		`INSERT INTO follow_requests(follower, followee, created_at) VALUES(?, ?, ?)`,
+		src, target, time.Now().Unix(),
+	)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return storage.ErrRequested
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// deleteRequest removes the follow request (src, target). Returns storage.ErrNoRequest if it does not exist
+func deleteRequest(ctx context.Context, tx *sql.Tx, src, target int) error {
+	res, err := tx.ExecContext(ctx, `DELETE FROM follow_requests WHERE follower=? AND followee=?`, src, target)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return storage.ErrNoRequest
+	}
+
+	return nil
+}
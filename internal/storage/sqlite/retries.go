@@ -0,0 +1,99 @@
+package sqlite
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"github.com/IlianBuh/Follow_Service/internal/domain/models"
+	"slices"
+	"time"
+)
+
+// SaveRetry stores the delivery which has to be attempted again
+func (s *Storage) SaveRetry(ctx context.Context, delivery models.Delivery) error {
+	const op = "sqlite.SaveRetry"
+
+	_, err := s.db.ExecContext(
+		ctx,
+		`INSERT INTO webhook_retries(event_id, endpoint, payload, error, attempts, next_attempt_at, created_at)
+			VALUES(?, ?, ?, ?, ?, ?, ?)`,
+		delivery.EventID, delivery.Endpoint, string(delivery.Payload), delivery.Error,
+		delivery.Attempts, delivery.NextAttemptAt.Unix(), time.Now().Unix(),
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// ClaimRetries returns at most 'limit' deliveries which are due in order they were stored.
+// The next attempt of returned deliveries is postponed to 'leasedUntil', so they are not
+// claimed again until then
+func (s *Storage) ClaimRetries(ctx context.Context, leasedUntil time.Time, limit int) ([]models.Delivery, error) {
+	const op = "sqlite.ClaimRetries"
+
+	rows, err := s.db.QueryContext(
+		ctx,
+		`UPDATE webhook_retries SET next_attempt_at=? WHERE id IN (
+			SELECT id FROM webhook_retries WHERE next_attempt_at<=? ORDER BY id LIMIT ?
+		) RETURNING id, event_id, endpoint, payload, error, attempts`,
+		leasedUntil.Unix(), time.Now().Unix(), limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	deliveries := make([]models.Delivery, 0, limit)
+	var (
+		temp    models.Delivery
+		payload string
+	)
+	for rows.Next() {
+		err = rows.Scan(&temp.ID, &temp.EventID, &temp.Endpoint, &payload, &temp.Error, &temp.Attempts)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		temp.Payload = []byte(payload)
+		temp.NextAttemptAt = time.Unix(leasedUntil.Unix(), 0)
+		deliveries = append(deliveries, temp)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	slices.SortFunc(deliveries, func(a, b models.Delivery) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	return deliveries, nil
+}
+
+// UpdateRetry stores the error, the number of attempts and the time of the next attempt of the delivery
+func (s *Storage) UpdateRetry(ctx context.Context, delivery models.Delivery) error {
+	const op = "sqlite.UpdateRetry"
+
+	_, err := s.db.ExecContext(
+		ctx,
+		`UPDATE webhook_retries SET error=?, attempts=?, next_attempt_at=? WHERE id=?`,
+		delivery.Error, delivery.Attempts, delivery.NextAttemptAt.Unix(), delivery.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// DeleteRetry deletes the delivery which is not attempted anymore
+func (s *Storage) DeleteRetry(ctx context.Context, id int) error {
+	const op = "sqlite.DeleteRetry"
+
+	if _, err := s.db.ExecContext(ctx, `DELETE FROM webhook_retries WHERE id=?`, id); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
@@ -5,43 +5,98 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"github.com/IlianBuh/Follow_Service/internal/domain/models"
 	"github.com/IlianBuh/Follow_Service/internal/storage"
 	"github.com/mattn/go-sqlite3"
+	"math"
+	"strings"
+	"time"
 )
 
 type Follower interface {
-	Follow(context.Context, int, int) error
+	Follow(ctx context.Context, src, target, maxFollowees int) (models.FollowState, error)
 }
 type Unfollower interface {
 	Unfollow(context.Context, int, int) error
 }
+type BulkFollower interface {
+	BulkFollow(ctx context.Context, src int, targets []int, maxFollowees int) ([]models.BulkResult, error)
+	BulkUnfollow(ctx context.Context, src int, targets []int) ([]models.BulkResult, error)
+}
 type FollowingsProvider interface {
-	ListFollowers(context.Context, int) ([]int, error)
-	ListFollowees(context.Context, int) ([]int, error)
+	ListFollowers(ctx context.Context, uuid, after, limit int, newestFirst bool) ([]models.Following, int, error)
+	ListFollowees(
+		ctx context.Context,
+		uuid, after, limit int,
+		newestFirst, excludeMuted bool,
+	) ([]models.Following, int, error)
+}
+type CommonFollowersProvider interface {
+	CommonFollowers(ctx context.Context, viewer, target, limit int) ([]int, int, error)
+}
+type CountersProvider interface {
+	CountFollowers(ctx context.Context, uuid int) (int, error)
+	CountFollowees(ctx context.Context, uuid int) (int, error)
+}
+type RelationshipsProvider interface {
+	Relationships(ctx context.Context, viewer int, targets []int) ([]models.Relationship, error)
+}
+type Blocker interface {
+	Block(ctx context.Context, src, target int) error
+	Unblock(ctx context.Context, src, target int) error
+	ListBlocked(ctx context.Context, uuid, after, limit int) ([]int, int, error)
+}
+type PrivacyManager interface {
+	SetPrivate(ctx context.Context, uuid int, private bool, maxFollowees int) error
+	IsPrivate(ctx context.Context, uuid int) (bool, error)
+}
+type RequestsManager interface {
+	ListFollowRequests(ctx context.Context, uuid, after, limit int) ([]models.Following, int, error)
+	ApproveFollowRequest(ctx context.Context, uuid, requester, maxFollowees int) error
+	RejectFollowRequest(ctx context.Context, uuid, requester int) error
+}
+type MutualsProvider interface {
+	ListMutuals(ctx context.Context, uuid, after, limit int) ([]models.Following, int, error)
+	CountMutuals(ctx context.Context, uuid int) (int, error)
+}
+type Muter interface {
+	Mute(ctx context.Context, src, target int) error
+	Unmute(ctx context.Context, src, target int) error
 }
+type SuggestionsProvider interface {
+	Suggestions(ctx context.Context, uuid, sources, limit int) ([]models.Suggestion, error)
+}
+type NeighboursProvider interface {
+	FolloweesOf(ctx context.Context, uuids []int, limit int) (map[int][]int, error)
+	FollowersOf(ctx context.Context, uuids []int, limit int) (map[int][]int, error)
+}
+type GraphEraser interface {
+	DeleteUserGraph(ctx context.Context, uuid int) (models.DeletedGraph, error)
+}
+type FollowingsStreamer interface {
+	StreamFollowers(ctx context.Context, uuid, size int, send func([]int) error) error
+	StreamFollowees(ctx context.Context, uuid, size int, send func([]int) error) error
+}
+
+// params make write transactions take the database lock when they begin, so concurrent writers
+// wait for each other up to the busy timeout instead of failing on upgrading of a read lock.
+// In WAL mode readers are not blocked by the writer
+const params = "_txlock=immediate&_journal_mode=WAL&_busy_timeout=5000"
 
 type Storage struct {
 	db *sql.DB
 }
 
-// New returns new instance of the repository. Database is stored by path 'path'
+// New returns new instance of the repository. Database is stored by path 'path'.
+// Schema of the database is maintained by the migrator
 func New(path string) (*Storage, error) {
 	const op = "sqlite.New"
-	db, err := sql.Open("sqlite3", path)
-	if err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
+	sep := "?"
+	if strings.Contains(path, "?") {
+		sep = "&"
 	}
 
-	_, err = db.Exec(
-		`CREATE TABLE IF NOT EXISTS followings(
-					id INTEGER PRIMARY KEY AUTOINCREMENT,
-					follower INTEGER NOT NULL,
-					followee INTEGER NOT NULL,
-					
-					CONSTRAINT unique_followings UNIQUE (follower, followee)
-				);
-				CREATE INDEX IF NOT EXISTS idx_follower ON followings(follower);
-				CREATE INDEX IF NOT EXISTS idx_followee ON followings(followee);`)
+	db, err := sql.Open("sqlite3", path+sep+params)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
@@ -51,40 +106,178 @@ func New(path string) (*Storage, error) {
 	}, nil
 }
 
-// Follow add new tuple into the database
-func (s *Storage) Follow(ctx context.Context, src, target int) error {
+// Follow add new tuple into the database and increments counters of both users.
+// If the target account is private, the follow request is stored instead.
+// ErrFolloweesLimit is returned if src already follows 'maxFollowees' users, zero means no limit
+func (s *Storage) Follow(ctx context.Context, src, target, maxFollowees int) (models.FollowState, error) {
 	const op = "sqlite.Follow"
 
-	prep, err := s.db.PrepareContext(ctx, `INSERT INTO followings(follower, followee) VALUES(?, ?)`)
+	state := models.FollowStateFollowed
+	err := s.withTx(ctx, func(tx *sql.Tx) error {
+		err := checkNotBlocked(ctx, tx, src, target)
+		if err != nil {
+			return err
+		}
+
+		if err = checkFolloweesLimit(ctx, tx, src, 1, maxFollowees); err != nil {
+			return err
+		}
+
+		private, err := isPrivate(ctx, tx, target)
+		if err != nil {
+			return err
+		}
+		if private {
+			state = models.FollowStateRequested
+			return insertRequest(ctx, tx, src, target)
+		}
+
+		return insertFollowing(ctx, tx, src, target)
+	})
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return state, nil
+}
+
+// Unfollow delete the tuple (src, target) from the database and decrements counters
+// of both users. ErrNoFollowing is returned if the tuple does not exist
+func (s *Storage) Unfollow(ctx context.Context, src, target int) error {
+	const op = "sqlite.Unfollow"
+
+	err := s.withTx(ctx, func(tx *sql.Tx) error {
+		removed, err := removeFollowing(ctx, tx, src, target)
+		if err != nil {
+			return err
+		}
+		if !removed {
+			return storage.ErrNoFollowing
+		}
+
+		return nil
+	})
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
-	defer prep.Close()
 
-	_, err = prep.ExecContext(ctx, src, target)
+	return nil
+}
+
+// ListFollowers returns at most 'limit' followers of the user with uuid ordered by id, from
+// the newest if 'newestFirst' is set. Only followings after the one with id 'after' are returned,
+// zero 'after' means the beginning of the list. The second value is the id of the last returned
+// following if there are more followers to list, otherwise zero
+func (s *Storage) ListFollowers(
+	ctx context.Context,
+	uuid, after, limit int,
+	newestFirst bool,
+) ([]models.Following, int, error) {
+	const op = "sqlite.ListFollowers"
+
+	query := `SELECT id, follower, created_at FROM followings WHERE followee=? AND id>? ORDER BY id LIMIT ?`
+	if newestFirst {
+		query = `SELECT id, follower, created_at FROM followings WHERE followee=? AND id<? ORDER BY id DESC LIMIT ?`
+		after = descCursor(after)
+	}
+
+	list, next, err := s.listPage(ctx, query, uuid, after, limit)
 	if err != nil {
-		var sqlerr sqlite3.Error
-		if errors.As(err, &sqlerr) && errors.Is(sqlerr.ExtendedCode, sqlite3.ErrConstraintUnique) {
-			return storage.ErrFollowing
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return list, next, nil
+}
+
+// ListFollowees returns at most 'limit' followees of the user with uuid ordered by id, from
+// the newest if 'newestFirst' is set. Muted followees are skipped if 'excludeMuted' is set.
+// Only followings after the one with id 'after' are returned, zero 'after' means the beginning
+// of the list. The second value is the id of the last returned following if there are more
+// followees to list, otherwise zero
+func (s *Storage) ListFollowees(
+	ctx context.Context,
+	uuid, after, limit int,
+	newestFirst, excludeMuted bool,
+) ([]models.Following, int, error) {
+	const op = "sqlite.ListFollowees"
+
+	filter := ""
+	if excludeMuted {
+		filter = ` AND NOT EXISTS (
+			SELECT 1 FROM mutes WHERE mutes.muter=followings.follower AND mutes.muted=followings.followee
+		)`
+	}
+
+	query := `SELECT id, followee, created_at FROM followings WHERE follower=? AND id>?` + filter + ` ORDER BY id LIMIT ?`
+	if newestFirst {
+		query = `SELECT id, followee, created_at FROM followings WHERE follower=? AND id<?` + filter + ` ORDER BY id DESC LIMIT ?`
+		after = descCursor(after)
+	}
+
+	list, next, err := s.listPage(ctx, query, uuid, after, limit)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return list, next, nil
+}
+
+// CommonFollowers returns at most 'limit' followees of the viewer who follow the target,
+// from the most recently followed by the viewer, and the total number of such users
+func (s *Storage) CommonFollowers(ctx context.Context, viewer, target, limit int) ([]int, int, error) {
+	const op = "sqlite.CommonFollowers"
+
+	rows, err := s.db.QueryContext(
+		ctx,
+		`SELECT a.followee, COUNT(*) OVER () FROM followings a
+			JOIN followings b ON b.follower=a.followee
+			WHERE a.follower=? AND b.followee=?
+			ORDER BY a.id DESC LIMIT ?`,
+		viewer, target, limit,
+	)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	sample := make([]int, 0, limit)
+	var temp, count int
+	for rows.Next() {
+		err = rows.Scan(&temp, &count)
+		if err != nil {
+			return nil, 0, fmt.Errorf("%s: %w", op, err)
 		}
 
-		return err
+		sample = append(sample, temp)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("%s: %w", op, err)
 	}
 
-	return nil
+	return sample, count, nil
 }
 
-// Unfollow delete the tuple (src, target) from the database
-func (s *Storage) Unfollow(ctx context.Context, src, target int) error {
-	const op = "sqlite.Unfollow"
+// StreamFollowers reads all followers of the user with uuid straight from the database cursor
+// and passes them to 'send' by chunks of at most 'size' elements. The chunk slice is reused
+// between calls, so 'send' must not retain it
+func (s *Storage) StreamFollowers(ctx context.Context, uuid, size int, send func([]int) error) error {
+	const op = "sqlite.StreamFollowers"
 
-	prep, err := s.db.PrepareContext(ctx, `DELETE FROM followings WHERE follower=? AND followee=?`)
+	err := s.stream(ctx, `SELECT follower FROM followings WHERE followee=? ORDER BY id`, uuid, size, send)
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
-	defer prep.Close()
 
-	_, err = prep.ExecContext(ctx, src, target)
+	return nil
+}
+
+// StreamFollowees reads all followees of the user with uuid straight from the database cursor
+// and passes them to 'send' by chunks of at most 'size' elements. The chunk slice is reused
+// between calls, so 'send' must not retain it
+func (s *Storage) StreamFollowees(ctx context.Context, uuid, size int, send func([]int) error) error {
+	const op = "sqlite.StreamFollowees"
+
+	err := s.stream(ctx, `SELECT followee FROM followings WHERE follower=? ORDER BY id`, uuid, size, send)
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
@@ -92,69 +285,168 @@ func (s *Storage) Unfollow(ctx context.Context, src, target int) error {
 	return nil
 }
 
-// ListFollowers returns lists of all followers of the user with uuid
-func (s *Storage) ListFollowers(ctx context.Context, uuid int) ([]int, error) {
-	const op = "sqlite.ListFollowers"
-
-	prep, err := s.db.PrepareContext(ctx, `SELECT follower FROM followings WHERE followee=?`)
+// stream executes query selecting uuids and passes result rows to 'send' by chunks
+func (s *Storage) stream(ctx context.Context, query string, uuid, size int, send func([]int) error) error {
+	prep, err := s.db.PrepareContext(ctx, query)
 	if err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return err
 	}
 	defer prep.Close()
 
 	rows, err := prep.QueryContext(ctx, uuid)
 	if err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return err
 	}
 	defer rows.Close()
 
-	list := make([]int, 0)
+	chunk := make([]int, 0, size)
 	var temp int
 	for rows.Next() {
 		err = rows.Scan(&temp)
 		if err != nil {
-			return nil, fmt.Errorf("%s: %w", op, err)
+			return err
 		}
 
-		list = append(list, temp)
+		chunk = append(chunk, temp)
+		if len(chunk) == size {
+			if err = send(chunk); err != nil {
+				return err
+			}
+			chunk = chunk[:0]
+		}
 	}
-
 	if err = rows.Err(); err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return err
+	}
+
+	if len(chunk) > 0 {
+		return send(chunk)
 	}
 
-	return list, nil
+	return nil
 }
 
-// ListFollowees returns lists of all followees of the user with uuid
-func (s *Storage) ListFollowees(ctx context.Context, uuid int) ([]int, error) {
-	const op = "sqlite.ListFollowees"
+// insertFollowing adds the tuple (src, target) into followings, increments counters of both users
+// and emits the followed event
+func insertFollowing(ctx context.Context, tx *sql.Tx, src, target int) error {
+	_, err := tx.ExecContext(
+		ctx,
+		`INSERT INTO followings(follower, followee, created_at) VALUES(?, ?, ?)`,
+		src, target, time.Now().Unix(),
+	)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return storage.ErrFollowing
+		}
+
+		return err
+	}
+
+	if err = addCounters(ctx, tx, src, target, 1); err != nil {
+		return err
+	}
 
-	prep, err := s.db.PrepareContext(ctx, `SELECT followee FROM followings WHERE follower=?`)
+	return insertEvent(ctx, tx, models.FollowEventFollowed, src, target)
+}
+
+// removeFollowing deletes the tuple (src, target) from followings, decrements counters
+// of both users and emits the unfollowed event if the tuple existed. It reports whether the tuple existed
+func removeFollowing(ctx context.Context, tx *sql.Tx, src, target int) (bool, error) {
+	res, err := tx.ExecContext(ctx, `DELETE FROM followings WHERE follower=? AND followee=?`, src, target)
 	if err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return false, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	if affected == 0 {
+		return false, nil
+	}
+
+	if err = addCounters(ctx, tx, src, target, -1); err != nil {
+		return false, err
+	}
+
+	return true, insertEvent(ctx, tx, models.FollowEventUnfollowed, src, target)
+}
+
+// isUniqueViolation reports whether err is violation of the unique constraint
+func isUniqueViolation(err error) bool {
+	var sqlerr sqlite3.Error
+
+	return errors.As(err, &sqlerr) && errors.Is(sqlerr.ExtendedCode, sqlite3.ErrConstraintUnique)
+}
+
+// withTx runs fn inside the transaction. The transaction is committed if fn succeeded,
+// otherwise it is rolled back
+func (s *Storage) withTx(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err = fn(tx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// listPage executes query selecting (id, uuid, created_at) rows and returns one page of followings.
+// One extra row is requested to find out whether the next page exists
+func (s *Storage) listPage(ctx context.Context, query string, uuid, after, limit int) ([]models.Following, int, error) {
+	prep, err := s.db.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, 0, err
 	}
 	defer prep.Close()
 
-	rows, err := prep.QueryContext(ctx, uuid)
+	rows, err := prep.QueryContext(ctx, uuid, after, limit+1)
 	if err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return nil, 0, err
 	}
 	defer rows.Close()
 
-	list := make([]int, 0)
-	var temp int
+	list := make([]models.Following, 0, limit)
+	var (
+		temp      models.Following
+		createdAt int64
+		next      int
+	)
 	for rows.Next() {
-		err = rows.Scan(&temp)
+		err = rows.Scan(&temp.ID, &temp.UUID, &createdAt)
 		if err != nil {
-			return nil, fmt.Errorf("%s: %w", op, err)
+			return nil, 0, err
+		}
+
+		if len(list) == limit {
+			next = list[len(list)-1].ID
+			break
+		}
+
+		temp.CreatedAt = time.Time{}
+		if createdAt > 0 {
+			temp.CreatedAt = time.Unix(createdAt, 0)
 		}
 
 		list = append(list, temp)
 	}
 	if err = rows.Err(); err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return nil, 0, err
+	}
+
+	return list, next, nil
+}
+
+// descCursor converts zero cursor, which means the beginning of the list, to the cursor
+// pointing before all rows in descending order
+func descCursor(after int) int {
+	if after == 0 {
+		return math.MaxInt
 	}
 
-	return list, nil
+	return after
 }
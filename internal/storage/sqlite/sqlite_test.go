@@ -0,0 +1,208 @@
+package sqlite_test
+
+import (
+	"context"
+	"errors"
+	"github.com/IlianBuh/Follow_Service/internal/domain/models"
+	"github.com/IlianBuh/Follow_Service/internal/migrator"
+	"github.com/IlianBuh/Follow_Service/internal/storage"
+	"github.com/IlianBuh/Follow_Service/internal/storage/sqlite"
+	"github.com/stretchr/testify/require"
+	"io"
+	"log/slog"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestConcurrentFollow(t *testing.T) {
+	const (
+		workers = 16
+		follows = 50
+		target  = 1
+	)
+
+	ctx, st := newStorage(t)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, workers*follows)
+	for w := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for i := range follows {
+				if _, err := st.Follow(ctx, 2+w*follows+i, target, 0); err != nil {
+					errs <- err
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	cnt, err := st.CountFollowers(ctx, target)
+	require.NoError(t, err)
+	require.Equal(t, workers*follows, cnt)
+}
+
+func TestFolloweesLimit(t *testing.T) {
+	const (
+		workers      = 8
+		follows      = 10
+		maxFollowees = 25
+		src          = 1
+	)
+
+	ctx, st := newStorage(t)
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		followed int
+	)
+	errs := make(chan error, workers*follows)
+	for w := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for i := range follows {
+				_, err := st.Follow(ctx, src, 2+w*follows+i, maxFollowees)
+				switch {
+				case err == nil:
+					mu.Lock()
+					followed++
+					mu.Unlock()
+				case !errors.Is(err, storage.ErrFolloweesLimit):
+					errs <- err
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	require.Equal(t, maxFollowees, followed)
+
+	_, err := st.BulkFollow(ctx, 2, []int{3, 4, 5}, 2)
+	require.ErrorIs(t, err, storage.ErrFolloweesLimit)
+	cnt, err := st.CountFollowees(ctx, 2)
+	require.NoError(t, err)
+	require.Zero(t, cnt)
+
+	const private = 2 + workers*follows
+	require.NoError(t, st.SetPrivate(ctx, private, true, 0))
+	_, err = st.Follow(ctx, src, private, 0)
+	require.NoError(t, err)
+	require.ErrorIs(t, st.ApproveFollowRequest(ctx, private, src, maxFollowees), storage.ErrFolloweesLimit)
+	require.NoError(t, st.ApproveFollowRequest(ctx, private, src, 0))
+}
+
+func TestSetPublicApprovesRequests(t *testing.T) {
+	const (
+		uuid         = 1
+		maxFollowees = 1
+	)
+
+	ctx, st := newStorage(t)
+	require.NoError(t, st.SetPrivate(ctx, uuid, true, 0))
+
+	for _, requester := range []int{2, 3, 4} {
+		state, err := st.Follow(ctx, requester, uuid, 0)
+		require.NoError(t, err)
+		require.Equal(t, models.FollowStateRequested, state)
+	}
+
+	// the requester 4 already follows the maximum number of users
+	_, err := st.Follow(ctx, 4, 5, 0)
+	require.NoError(t, err)
+
+	require.NoError(t, st.SetPrivate(ctx, uuid, false, maxFollowees))
+
+	requests, _, err := st.ListFollowRequests(ctx, uuid, 0, 10)
+	require.NoError(t, err)
+	require.Empty(t, requests)
+
+	followers, _, err := st.ListFollowers(ctx, uuid, 0, 10, false)
+	require.NoError(t, err)
+	require.Len(t, followers, 2)
+	require.Equal(t, 2, followers[0].UUID)
+	require.Equal(t, 3, followers[1].UUID)
+
+	cnt, err := st.CountFollowers(ctx, uuid)
+	require.NoError(t, err)
+	require.Equal(t, 2, cnt)
+
+	// making the public account public again changes nothing
+	require.NoError(t, st.SetPrivate(ctx, uuid, false, maxFollowees))
+	cnt, err = st.CountFollowers(ctx, uuid)
+	require.NoError(t, err)
+	require.Equal(t, 2, cnt)
+}
+
+func TestDeletePublishedEvents(t *testing.T) {
+	ctx, st := newStorage(t)
+
+	for target := 2; target <= 4; target++ {
+		_, err := st.Follow(ctx, 1, target, 0)
+		require.NoError(t, err)
+	}
+
+	events, err := st.ClaimEvents(ctx, "relay", time.Now().Add(time.Minute), 10)
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	require.NoError(t, st.MarkPublished(ctx, []int{events[0].ID, events[1].ID}))
+
+	// unsequenced events are kept for watchers even if they are published
+	deleted, err := st.DeletePublishedEvents(ctx, time.Now().Add(time.Minute), 10)
+	require.NoError(t, err)
+	require.Zero(t, deleted)
+
+	require.NoError(t, st.SequenceEvents(ctx))
+	require.NoError(t, st.SequenceEvents(ctx))
+
+	deleted, err = st.DeletePublishedEvents(ctx, time.Now().Add(-time.Minute), 10)
+	require.NoError(t, err)
+	require.Zero(t, deleted)
+
+	deleted, err = st.DeletePublishedEvents(ctx, time.Now().Add(time.Minute), 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, deleted)
+	deleted, err = st.DeletePublishedEvents(ctx, time.Now().Add(time.Minute), 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, deleted)
+
+	left, err := st.EventsAfter(ctx, 0, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, left, 1)
+	require.Equal(t, events[2].ID, left[0].ID)
+}
+
+// newStorage returns storage of the new database with all migrations applied
+func newStorage(t *testing.T) (context.Context, *sqlite.Storage) {
+	t.Helper()
+
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "storage.db")
+
+	m, err := migrator.New(slog.New(slog.NewTextHandler(io.Discard, nil)), "sqlite", path)
+	require.NoError(t, err)
+	defer m.Close()
+
+	_, err = m.Up(ctx)
+	require.NoError(t, err)
+
+	st, err := sqlite.New(path)
+	require.NoError(t, err)
+
+	return ctx, st
+}
@@ -0,0 +1,51 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"github.com/IlianBuh/Follow_Service/internal/domain/models"
+)
+
+// Suggestions returns at most 'limit' users followed by followees of the user with uuid, ranked
+// by number of such followees. Only 'sources' followees followed most recently are considered.
+// The user, users already followed or requested by the user and users blocked in any
+// direction are excluded
+func (s *Storage) Suggestions(ctx context.Context, uuid, sources, limit int) ([]models.Suggestion, error) {
+	const op = "sqlite.Suggestions"
+
+	rows, err := s.db.QueryContext(
+		ctx,
+		`SELECT b.followee, COUNT(*) AS paths
+			FROM (SELECT followee FROM followings WHERE follower=? ORDER BY id DESC LIMIT ?) a
+			JOIN followings b ON b.follower=a.followee
+			WHERE b.followee<>?
+				AND NOT EXISTS (SELECT 1 FROM followings WHERE follower=? AND followee=b.followee)
+				AND NOT EXISTS (SELECT 1 FROM follow_requests WHERE follower=? AND followee=b.followee)
+				AND NOT EXISTS (
+					SELECT 1 FROM blocks
+					WHERE (blocker=? AND blocked=b.followee) OR (blocker=b.followee AND blocked=?)
+				)
+			GROUP BY b.followee ORDER BY paths DESC, b.followee LIMIT ?`,
+		uuid, sources, uuid, uuid, uuid, uuid, uuid, limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	res := make([]models.Suggestion, 0, limit)
+	var temp models.Suggestion
+	for rows.Next() {
+		err = rows.Scan(&temp.UUID, &temp.Paths)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		res = append(res, temp)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return res, nil
+}
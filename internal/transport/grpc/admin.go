@@ -0,0 +1,56 @@
+package grpcfllw
+
+import (
+	"context"
+	"github.com/IlianBuh/Follow_Service/internal/domain/models"
+	followv1 "github.com/IlianBuh/Follow_Service/protobuf/gen/go"
+	"google.golang.org/grpc"
+)
+
+type Admin interface {
+	DeleteUserGraph(ctx context.Context, uuid int) (models.DeletedGraph, error)
+}
+type adminAPI struct {
+	adm  Admin
+	wtch Watcher
+	followv1.UnimplementedFollowAdminServer
+}
+
+// RegisterAdmin registers administrative handlers on grpc server
+func RegisterAdmin(grpcsrv *grpc.Server, adm Admin, wtch Watcher) {
+	followv1.RegisterFollowAdminServer(grpcsrv, &adminAPI{adm: adm, wtch: wtch})
+}
+
+// DeleteUserGraph is API-handler for DeleteUserGraph method
+func (a *adminAPI) DeleteUserGraph(
+	ctx context.Context,
+	req *followv1.DeleteUserGraphRequest,
+) (*followv1.DeleteUserGraphResponse, error) {
+	pars := int32ToInt(req.GetUuid())
+
+	deleted, err := a.adm.DeleteUserGraph(ctx, pars[0])
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &followv1.DeleteUserGraphResponse{
+		Followers: int64(deleted.Followers),
+		Followees: int64(deleted.Followees),
+		Requests:  int64(deleted.Requests),
+		Blocks:    int64(deleted.Blocks),
+		Mutes:     int64(deleted.Mutes),
+	}, nil
+}
+
+// WatchAllFollows is API-handler for WatchAllFollows method
+func (a *adminAPI) WatchAllFollows(
+	req *followv1.WatchAllFollowsRequest,
+	stream grpc.ServerStreamingServer[followv1.FollowEvent],
+) error {
+	err := a.wtch.WatchAllFollows(stream.Context(), req.GetResumeToken(), sendEvent(stream))
+	if err != nil {
+		return toStatus(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package grpcfllw
+
+import (
+	"context"
+	"errors"
+	"github.com/IlianBuh/Follow_Service/internal/lib/validator"
+	"github.com/IlianBuh/Follow_Service/internal/service/follow"
+	"github.com/IlianBuh/Follow_Service/internal/service/graph"
+	"github.com/IlianBuh/Follow_Service/internal/service/idempotency"
+	"github.com/IlianBuh/Follow_Service/internal/service/ratelimit"
+	"github.com/IlianBuh/Follow_Service/internal/service/watch"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"strconv"
+	"strings"
+)
+
+const (
+	// errorDomain is the domain of ErrorInfo attached to statuses
+	errorDomain = "follow"
+
+	// metadataUUIDs is the ErrorInfo metadata key of comma separated offending uuids
+	metadataUUIDs = "uuids"
+
+	reasonInternal = "INTERNAL"
+)
+
+// statusMapping describes the status which the error is passed to clients with
+type statusMapping struct {
+	err    error
+	code   codes.Code
+	reason string
+}
+
+// statusMappings maps errors to statuses, the first matching one is used.
+// Errors without mapping are passed as internal ones
+var statusMappings = []statusMapping{
+	{validator.ErrInvalidUUID, codes.InvalidArgument, "INVALID_UUID"},
+	{validator.ErrSelfTarget, codes.InvalidArgument, "SELF_TARGET"},
+	{validator.ErrBatchTooLarge, codes.InvalidArgument, "BATCH_TOO_LARGE"},
+	{follow.ErrInvalidUUIDs, codes.InvalidArgument, "USERS_NOT_FOUND"},
+	{follow.ErrInvalidPageToken, codes.InvalidArgument, "INVALID_PAGE_TOKEN"},
+	{follow.ErrFollowing, codes.AlreadyExists, "ALREADY_FOLLOWING"},
+	{follow.ErrNoFollowing, codes.NotFound, "NOT_FOLLOWING"},
+	{follow.ErrBlocking, codes.AlreadyExists, "ALREADY_BLOCKED"},
+	{follow.ErrNoBlocking, codes.NotFound, "NOT_BLOCKED"},
+	{follow.ErrBlocked, codes.FailedPrecondition, "FOLLOWING_BLOCKED"},
+	{follow.ErrRequested, codes.AlreadyExists, "ALREADY_REQUESTED"},
+	{follow.ErrNoRequest, codes.NotFound, "REQUEST_NOT_FOUND"},
+	{follow.ErrMuting, codes.AlreadyExists, "ALREADY_MUTED"},
+	{follow.ErrNoMuting, codes.NotFound, "NOT_MUTED"},
+	{follow.ErrUserInfoUnavailable, codes.Unavailable, "USER_INFO_UNAVAILABLE"},
+	{follow.ErrFolloweesLimit, codes.ResourceExhausted, "FOLLOWEES_LIMIT_EXCEEDED"},
+	{ratelimit.ErrLimitExceeded, codes.ResourceExhausted, "RATE_LIMIT_EXCEEDED"},
+	{graph.ErrSearchLimit, codes.ResourceExhausted, "SEARCH_LIMIT_EXCEEDED"},
+	{watch.ErrInvalidResumeToken, codes.InvalidArgument, "INVALID_RESUME_TOKEN"},
+	{watch.ErrStopped, codes.Unavailable, "WATCH_STOPPED"},
+	{idempotency.ErrInvalidKey, codes.InvalidArgument, "INVALID_IDEMPOTENCY_KEY"},
+	{idempotency.ErrKeyReused, codes.InvalidArgument, "IDEMPOTENCY_KEY_REUSED"},
+	{context.Canceled, codes.Canceled, "CANCELED"},
+	{context.DeadlineExceeded, codes.DeadlineExceeded, "DEADLINE_EXCEEDED"},
+}
+
+// toStatus translates the error to the grpc status error. The status carries ErrorInfo
+// with the reason code, uuids of users which caused the error are put into its metadata.
+// Exceeded rate limits also carry RetryInfo with the delay after which the call may succeed
+func toStatus(err error) error {
+	code, reason := codes.Internal, reasonInternal
+	for _, v := range statusMappings {
+		if errors.Is(err, v.err) {
+			code, reason = v.code, v.reason
+			break
+		}
+	}
+
+	info := &errdetails.ErrorInfo{Reason: reason, Domain: errorDomain}
+	var usrErr *follow.UsersError
+	if errors.As(err, &usrErr) {
+		info.Metadata = map[string]string{metadataUUIDs: joinInts(usrErr.UUIDs)}
+	}
+
+	details := []protoadapt.MessageV1{info}
+	var lmtErr *ratelimit.LimitError
+	if errors.As(err, &lmtErr) {
+		details = append(details, &errdetails.RetryInfo{RetryDelay: durationpb.New(lmtErr.RetryAfter)})
+	}
+
+	st, detErr := status.New(code, err.Error()).WithDetails(details...)
+	if detErr != nil {
+		return status.Error(code, err.Error())
+	}
+
+	return st.Err()
+}
+
+// joinInts returns comma separated values
+func joinInts(vals []int) string {
+	strs := make([]string, len(vals))
+	for i, v := range vals {
+		strs[i] = strconv.Itoa(v)
+	}
+
+	return strings.Join(strs, ",")
+}
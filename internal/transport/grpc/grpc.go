@@ -2,29 +2,89 @@ package grpcfllw
 
 import (
 	"context"
-	"errors"
-	"fmt"
-	followv1 "github.com/IlianBuh/Follow_Protobuf/gen/go"
-	"github.com/IlianBuh/Follow_Service/internal/service/follow"
+	"github.com/IlianBuh/Follow_Service/internal/domain/models"
+	followv1 "github.com/IlianBuh/Follow_Service/protobuf/gen/go"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type Service interface {
-	Follow(ctx context.Context, src, target int) error
-	Unfollow(ctx context.Context, src, target int) error
-	ListFollowers(ctx context.Context, uuid int) ([]int, error)
-	ListFollowees(ctx context.Context, uuid int) ([]int, error)
+	Follow(ctx context.Context, src, target int) (models.FollowState, error)
+	Unfollow(ctx context.Context, src, target int, strict bool) (bool, error)
+	BulkFollow(ctx context.Context, src int, targets []int) ([]models.BulkResult, error)
+	BulkUnfollow(ctx context.Context, src int, targets []int) ([]models.BulkResult, error)
+	ListFollowers(
+		ctx context.Context,
+		uuid, pageSize int,
+		pageToken string,
+		newestFirst bool,
+	) ([]models.Following, string, error)
+	ListFollowees(
+		ctx context.Context,
+		uuid, pageSize int,
+		pageToken string,
+		newestFirst, excludeMuted bool,
+	) ([]models.Following, string, error)
+	CommonFollowers(ctx context.Context, viewer, target, sampleSize int) ([]int, int, error)
+	StreamFollowers(ctx context.Context, uuid, chunkSize int, send func([]int) error) error
+	StreamFollowees(ctx context.Context, uuid, chunkSize int, send func([]int) error) error
+	CountFollowers(ctx context.Context, uuid int) (int, error)
+	CountFollowees(ctx context.Context, uuid int) (int, error)
+	Relationships(ctx context.Context, viewer int, targets []int) ([]models.Relationship, error)
+	Block(ctx context.Context, src, target int) error
+	Unblock(ctx context.Context, src, target int) error
+	ListBlocked(ctx context.Context, uuid, pageSize int, pageToken string) ([]int, string, error)
+	SetPrivacy(ctx context.Context, uuid int, private bool) error
+	IsPrivate(ctx context.Context, uuid int) (bool, error)
+	ListFollowRequests(ctx context.Context, uuid, pageSize int, pageToken string) ([]models.Following, string, error)
+	ApproveFollowRequest(ctx context.Context, uuid, requester int) error
+	RejectFollowRequest(ctx context.Context, uuid, requester int) error
+	Mute(ctx context.Context, src, target int) error
+	Unmute(ctx context.Context, src, target int) error
+	ListMutuals(ctx context.Context, uuid, pageSize int, pageToken string) ([]models.Following, string, error)
+	CountMutuals(ctx context.Context, uuid int) (int, error)
 }
+type Suggester interface {
+	SuggestFollows(ctx context.Context, uuid, limit int) ([]models.Suggestion, error)
+}
+type PathFinder interface {
+	FollowPath(ctx context.Context, src, dst, depth int) ([]int, error)
+}
+
+// bulkStatuses maps statuses of the bulk operation to their proto representation
+var bulkStatuses = map[models.BulkStatus]followv1.BulkStatus{
+	models.BulkStatusFollowed:         followv1.BulkStatus_BULK_STATUS_FOLLOWED,
+	models.BulkStatusRequested:        followv1.BulkStatus_BULK_STATUS_REQUESTED,
+	models.BulkStatusAlreadyFollowing: followv1.BulkStatus_BULK_STATUS_ALREADY_FOLLOWING,
+	models.BulkStatusAlreadyRequested: followv1.BulkStatus_BULK_STATUS_ALREADY_REQUESTED,
+	models.BulkStatusBlocked:          followv1.BulkStatus_BULK_STATUS_BLOCKED,
+	models.BulkStatusInvalidUser:      followv1.BulkStatus_BULK_STATUS_INVALID_USER,
+	models.BulkStatusUnfollowed:       followv1.BulkStatus_BULK_STATUS_UNFOLLOWED,
+	models.BulkStatusNotFollowing:     followv1.BulkStatus_BULK_STATUS_NOT_FOLLOWING,
+}
+
 type serverAPI struct {
 	fllw Service
+	sgst Suggester
+	pthf PathFinder
+	wtch Watcher
+	idmp Idempotency
 	followv1.UnimplementedFollowServer
 }
 
 // Register registers handlers on grpc server
-func Register(grpcsrv *grpc.Server, fllw Service) {
-	followv1.RegisterFollowServer(grpcsrv, &serverAPI{fllw: fllw})
+func Register(
+	grpcsrv *grpc.Server,
+	fllw Service,
+	sgst Suggester,
+	pthf PathFinder,
+	wtch Watcher,
+	idmp Idempotency,
+) {
+	followv1.RegisterFollowServer(
+		grpcsrv,
+		&serverAPI{fllw: fllw, sgst: sgst, pthf: pthf, wtch: wtch, idmp: idmp},
+	)
 }
 
 // Follow is API-handler for Follow method
@@ -34,20 +94,15 @@ func (s *serverAPI) Follow(
 ) (*followv1.FollowResponse, error) {
 	pars := int32ToInt(req.GetSrc(), req.GetTarget())
 
-	if err := validateIntValues(pars[0], pars[1]); err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
-	}
-
-	err := s.fllw.Follow(ctx, pars[0], pars[1])
-	if err != nil {
-		if errors.Is(err, follow.ErrInvalidUUIDs) {
-			return nil, status.Error(codes.InvalidArgument, err.Error())
+	resp := &followv1.FollowResponse{}
+	return idempotent(ctx, s.idmp, pars[0], req.GetIdempotencyKey(), req, resp, func() (*followv1.FollowResponse, error) {
+		state, err := s.fllw.Follow(ctx, pars[0], pars[1])
+		if err != nil {
+			return nil, toStatus(err)
 		}
 
-		return nil, status.Error(codes.Internal, err.Error())
-	}
-
-	return &followv1.FollowResponse{}, nil
+		return &followv1.FollowResponse{State: followStateToProto(state)}, nil
+	})
 }
 
 // Unfollow is API-handler for Unfollow method
@@ -57,18 +112,47 @@ func (s *serverAPI) Unfollow(
 ) (*followv1.UnfollowResponse, error) {
 	pars := int32ToInt(req.GetSrc(), req.GetTarget())
 
-	if err := validateIntValues(pars[0], pars[1]); err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
-	}
+	resp := &followv1.UnfollowResponse{}
+	return idempotent(ctx, s.idmp, pars[0], req.GetIdempotencyKey(), req, resp, func() (*followv1.UnfollowResponse, error) {
+		unfollowed, err := s.fllw.Unfollow(ctx, pars[0], pars[1], req.GetStrict())
+		if err != nil {
+			return nil, toStatus(err)
+		}
 
-	err := s.fllw.Unfollow(ctx, pars[0], pars[1])
+		return &followv1.UnfollowResponse{Unfollowed: unfollowed}, nil
+	})
+}
+
+// BulkFollow is API-handler for BulkFollow method
+func (s *serverAPI) BulkFollow(
+	ctx context.Context,
+	req *followv1.BulkFollowRequest,
+) (*followv1.BulkFollowResponse, error) {
+	src := int(req.GetSrc())
+	targets := int32ToInt(req.GetTargets()...)
+
+	res, err := s.fllw.BulkFollow(ctx, src, targets)
 	if err != nil {
-		// TODO : handle error when users are not found
+		return nil, toStatus(err)
+	}
+
+	return &followv1.BulkFollowResponse{Results: bulkResultsToProto(res)}, nil
+}
 
-		return nil, status.Error(codes.Internal, err.Error())
+// BulkUnfollow is API-handler for BulkUnfollow method
+func (s *serverAPI) BulkUnfollow(
+	ctx context.Context,
+	req *followv1.BulkUnfollowRequest,
+) (*followv1.BulkUnfollowResponse, error) {
+	src := int(req.GetSrc())
+	targets := int32ToInt(req.GetTargets()...)
+
+	res, err := s.fllw.BulkUnfollow(ctx, src, targets)
+	if err != nil {
+		return nil, toStatus(err)
 	}
 
-	return &followv1.UnfollowResponse{}, nil
+	return &followv1.BulkUnfollowResponse{Results: bulkResultsToProto(res)}, nil
 }
 
 // ListFollowers is API-handler for ListFollowers method
@@ -76,20 +160,22 @@ func (s *serverAPI) ListFollowers(
 	ctx context.Context,
 	req *followv1.ListFollowersRequest,
 ) (*followv1.ListFollowersResponse, error) {
-	pars := int32ToInt(req.GetUuid())
+	pars := int32ToInt(req.GetUuid(), req.GetPageSize())
 
-	if err := validateIntValues(pars[0]); err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
-	}
+	newestFirst := req.GetOrder() == followv1.Order_ORDER_NEWEST_FIRST
 
-	uuids, err := s.fllw.ListFollowers(ctx, pars[0])
+	list, next, err := s.fllw.ListFollowers(ctx, pars[0], pars[1], req.GetPageToken(), newestFirst)
 	if err != nil {
-		// TODO : handle error when users are not found
-
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, toStatus(err)
 	}
 
-	return &followv1.ListFollowersResponse{Uuids: intToInt32(uuids...)}, nil
+	uuids, followings := followingsToProto(list)
+
+	return &followv1.ListFollowersResponse{
+		Uuids:         uuids,
+		NextPageToken: next,
+		Followings:    followings,
+	}, nil
 }
 
 // ListFollowees is API-handler for ListFollowees method
@@ -97,31 +183,411 @@ func (s *serverAPI) ListFollowees(
 	ctx context.Context,
 	req *followv1.ListFolloweesRequest,
 ) (*followv1.ListFolloweesResponse, error) {
+	pars := int32ToInt(req.GetUuid(), req.GetPageSize())
+
+	newestFirst := req.GetOrder() == followv1.Order_ORDER_NEWEST_FIRST
+
+	list, next, err := s.fllw.ListFollowees(
+		ctx,
+		pars[0], pars[1],
+		req.GetPageToken(),
+		newestFirst, req.GetExcludeMuted(),
+	)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	uuids, followings := followingsToProto(list)
+
+	return &followv1.ListFolloweesResponse{
+		Uuids:         uuids,
+		NextPageToken: next,
+		Followings:    followings,
+	}, nil
+}
+
+// GetCommonFollowers is API-handler for GetCommonFollowers method
+func (s *serverAPI) GetCommonFollowers(
+	ctx context.Context,
+	req *followv1.GetCommonFollowersRequest,
+) (*followv1.GetCommonFollowersResponse, error) {
+	pars := int32ToInt(req.GetViewer(), req.GetTarget(), req.GetSampleSize())
+
+	sample, count, err := s.fllw.CommonFollowers(ctx, pars[0], pars[1], pars[2])
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &followv1.GetCommonFollowersResponse{
+		Count:  int64(count),
+		Sample: intToInt32(sample...),
+	}, nil
+}
+
+// StreamFollowers is API-handler for StreamFollowers method
+func (s *serverAPI) StreamFollowers(
+	req *followv1.StreamFollowersRequest,
+	stream grpc.ServerStreamingServer[followv1.StreamFollowersResponse],
+) error {
+	pars := int32ToInt(req.GetUuid(), req.GetChunkSize())
+
+	err := s.fllw.StreamFollowers(stream.Context(), pars[0], pars[1], func(uuids []int) error {
+		return stream.Send(&followv1.StreamFollowersResponse{Uuids: intToInt32(uuids...)})
+	})
+	if err != nil {
+		return toStatus(err)
+	}
+
+	return nil
+}
+
+// StreamFollowees is API-handler for StreamFollowees method
+func (s *serverAPI) StreamFollowees(
+	req *followv1.StreamFolloweesRequest,
+	stream grpc.ServerStreamingServer[followv1.StreamFolloweesResponse],
+) error {
+	pars := int32ToInt(req.GetUuid(), req.GetChunkSize())
+
+	err := s.fllw.StreamFollowees(stream.Context(), pars[0], pars[1], func(uuids []int) error {
+		return stream.Send(&followv1.StreamFolloweesResponse{Uuids: intToInt32(uuids...)})
+	})
+	if err != nil {
+		return toStatus(err)
+	}
+
+	return nil
+}
+
+// CountFollowers is API-handler for CountFollowers method
+func (s *serverAPI) CountFollowers(
+	ctx context.Context,
+	req *followv1.CountFollowersRequest,
+) (*followv1.CountFollowersResponse, error) {
+	pars := int32ToInt(req.GetUuid())
+
+	count, err := s.fllw.CountFollowers(ctx, pars[0])
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &followv1.CountFollowersResponse{Count: int64(count)}, nil
+}
+
+// CountFollowees is API-handler for CountFollowees method
+func (s *serverAPI) CountFollowees(
+	ctx context.Context,
+	req *followv1.CountFolloweesRequest,
+) (*followv1.CountFolloweesResponse, error) {
+	pars := int32ToInt(req.GetUuid())
+
+	count, err := s.fllw.CountFollowees(ctx, pars[0])
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &followv1.CountFolloweesResponse{Count: int64(count)}, nil
+}
+
+// GetRelationships is API-handler for GetRelationships method
+func (s *serverAPI) GetRelationships(
+	ctx context.Context,
+	req *followv1.GetRelationshipsRequest,
+) (*followv1.GetRelationshipsResponse, error) {
+	viewer := int(req.GetViewer())
+	targets := int32ToInt(req.GetTargets()...)
+
+	rels, err := s.fllw.Relationships(ctx, viewer, targets)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	res := make([]*followv1.Relationship, len(rels))
+	for i, r := range rels {
+		res[i] = &followv1.Relationship{
+			Uuid:       int32(r.UUID),
+			Following:  r.Following,
+			FollowedBy: r.FollowedBy,
+			Mutual:     r.Mutual(),
+		}
+	}
+
+	return &followv1.GetRelationshipsResponse{Relationships: res}, nil
+}
+
+// Block is API-handler for Block method
+func (s *serverAPI) Block(
+	ctx context.Context,
+	req *followv1.BlockRequest,
+) (*followv1.BlockResponse, error) {
+	pars := int32ToInt(req.GetSrc(), req.GetTarget())
+
+	err := s.fllw.Block(ctx, pars[0], pars[1])
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &followv1.BlockResponse{}, nil
+}
+
+// Unblock is API-handler for Unblock method
+func (s *serverAPI) Unblock(
+	ctx context.Context,
+	req *followv1.UnblockRequest,
+) (*followv1.UnblockResponse, error) {
+	pars := int32ToInt(req.GetSrc(), req.GetTarget())
+
+	err := s.fllw.Unblock(ctx, pars[0], pars[1])
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &followv1.UnblockResponse{}, nil
+}
+
+// ListBlocked is API-handler for ListBlocked method
+func (s *serverAPI) ListBlocked(
+	ctx context.Context,
+	req *followv1.ListBlockedRequest,
+) (*followv1.ListBlockedResponse, error) {
+	pars := int32ToInt(req.GetUuid(), req.GetPageSize())
+
+	uuids, next, err := s.fllw.ListBlocked(ctx, pars[0], pars[1], req.GetPageToken())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &followv1.ListBlockedResponse{
+		Uuids:         intToInt32(uuids...),
+		NextPageToken: next,
+	}, nil
+}
+
+// SetPrivacy is API-handler for SetPrivacy method
+func (s *serverAPI) SetPrivacy(
+	ctx context.Context,
+	req *followv1.SetPrivacyRequest,
+) (*followv1.SetPrivacyResponse, error) {
 	pars := int32ToInt(req.GetUuid())
 
-	if err := validateIntValues(pars[0]); err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+	err := s.fllw.SetPrivacy(ctx, pars[0], req.GetPrivate())
+	if err != nil {
+		return nil, toStatus(err)
 	}
 
-	uuids, err := s.fllw.ListFollowees(ctx, pars[0])
+	return &followv1.SetPrivacyResponse{}, nil
+}
+
+// GetPrivacy is API-handler for GetPrivacy method
+func (s *serverAPI) GetPrivacy(
+	ctx context.Context,
+	req *followv1.GetPrivacyRequest,
+) (*followv1.GetPrivacyResponse, error) {
+	pars := int32ToInt(req.GetUuid())
+
+	private, err := s.fllw.IsPrivate(ctx, pars[0])
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &followv1.GetPrivacyResponse{Private: private}, nil
+}
+
+// ListFollowRequests is API-handler for ListFollowRequests method
+func (s *serverAPI) ListFollowRequests(
+	ctx context.Context,
+	req *followv1.ListFollowRequestsRequest,
+) (*followv1.ListFollowRequestsResponse, error) {
+	pars := int32ToInt(req.GetUuid(), req.GetPageSize())
+
+	list, next, err := s.fllw.ListFollowRequests(ctx, pars[0], pars[1], req.GetPageToken())
 	if err != nil {
-		// TODO : handle error when users are not found
+		return nil, toStatus(err)
+	}
 
-		return nil, status.Error(codes.Internal, err.Error())
+	requests := make([]*followv1.PendingFollow, len(list))
+	for i, v := range list {
+		requests[i] = &followv1.PendingFollow{
+			Uuid:        int32(v.UUID),
+			RequestedAt: timestamppb.New(v.CreatedAt),
+		}
 	}
 
-	return &followv1.ListFolloweesResponse{Uuids: intToInt32(uuids...)}, nil
+	return &followv1.ListFollowRequestsResponse{
+		Requests:      requests,
+		NextPageToken: next,
+	}, nil
 }
 
-// validateIntValues validates value to be non-negative
-func validateIntValues(vals ...int) error {
-	for _, v := range vals {
-		if v < 0 {
-			return fmt.Errorf("uuid can't be negative")
+// ApproveFollowRequest is API-handler for ApproveFollowRequest method
+func (s *serverAPI) ApproveFollowRequest(
+	ctx context.Context,
+	req *followv1.ApproveFollowRequestRequest,
+) (*followv1.ApproveFollowRequestResponse, error) {
+	pars := int32ToInt(req.GetUuid(), req.GetRequester())
+
+	err := s.fllw.ApproveFollowRequest(ctx, pars[0], pars[1])
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &followv1.ApproveFollowRequestResponse{}, nil
+}
+
+// RejectFollowRequest is API-handler for RejectFollowRequest method
+func (s *serverAPI) RejectFollowRequest(
+	ctx context.Context,
+	req *followv1.RejectFollowRequestRequest,
+) (*followv1.RejectFollowRequestResponse, error) {
+	pars := int32ToInt(req.GetUuid(), req.GetRequester())
+
+	err := s.fllw.RejectFollowRequest(ctx, pars[0], pars[1])
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &followv1.RejectFollowRequestResponse{}, nil
+}
+
+// Mute is API-handler for Mute method
+func (s *serverAPI) Mute(
+	ctx context.Context,
+	req *followv1.MuteRequest,
+) (*followv1.MuteResponse, error) {
+	pars := int32ToInt(req.GetSrc(), req.GetTarget())
+
+	err := s.fllw.Mute(ctx, pars[0], pars[1])
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &followv1.MuteResponse{}, nil
+}
+
+// Unmute is API-handler for Unmute method
+func (s *serverAPI) Unmute(
+	ctx context.Context,
+	req *followv1.UnmuteRequest,
+) (*followv1.UnmuteResponse, error) {
+	pars := int32ToInt(req.GetSrc(), req.GetTarget())
+
+	err := s.fllw.Unmute(ctx, pars[0], pars[1])
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &followv1.UnmuteResponse{}, nil
+}
+
+// ListMutuals is API-handler for ListMutuals method
+func (s *serverAPI) ListMutuals(
+	ctx context.Context,
+	req *followv1.ListMutualsRequest,
+) (*followv1.ListMutualsResponse, error) {
+	pars := int32ToInt(req.GetUuid(), req.GetPageSize())
+
+	list, next, err := s.fllw.ListMutuals(ctx, pars[0], pars[1], req.GetPageToken())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	uuids, followings := followingsToProto(list)
+
+	return &followv1.ListMutualsResponse{
+		Uuids:         uuids,
+		NextPageToken: next,
+		Followings:    followings,
+	}, nil
+}
+
+// CountMutuals is API-handler for CountMutuals method
+func (s *serverAPI) CountMutuals(
+	ctx context.Context,
+	req *followv1.CountMutualsRequest,
+) (*followv1.CountMutualsResponse, error) {
+	pars := int32ToInt(req.GetUuid())
+
+	count, err := s.fllw.CountMutuals(ctx, pars[0])
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &followv1.CountMutualsResponse{Count: int64(count)}, nil
+}
+
+// SuggestFollows is API-handler for SuggestFollows method
+func (s *serverAPI) SuggestFollows(
+	ctx context.Context,
+	req *followv1.SuggestFollowsRequest,
+) (*followv1.SuggestFollowsResponse, error) {
+	pars := int32ToInt(req.GetUuid(), req.GetLimit())
+
+	suggestions, err := s.sgst.SuggestFollows(ctx, pars[0], pars[1])
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	res := make([]*followv1.Suggestion, len(suggestions))
+	for i, v := range suggestions {
+		res[i] = &followv1.Suggestion{
+			Uuid:  int32(v.UUID),
+			Paths: int32(v.Paths),
 		}
 	}
 
-	return nil
+	return &followv1.SuggestFollowsResponse{Suggestions: res}, nil
+}
+
+// FollowPath is API-handler for FollowPath method
+func (s *serverAPI) FollowPath(
+	ctx context.Context,
+	req *followv1.FollowPathRequest,
+) (*followv1.FollowPathResponse, error) {
+	pars := int32ToInt(req.GetSrc(), req.GetDst(), req.GetMaxDepth())
+
+	path, err := s.pthf.FollowPath(ctx, pars[0], pars[1], pars[2])
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &followv1.FollowPathResponse{Path: intToInt32(path...)}, nil
+}
+
+// followStateToProto converts follow state to its proto representation
+func followStateToProto(state models.FollowState) followv1.FollowState {
+	if state == models.FollowStateRequested {
+		return followv1.FollowState_FOLLOW_STATE_REQUESTED
+	}
+
+	return followv1.FollowState_FOLLOW_STATE_FOLLOWED
+}
+
+// bulkResultsToProto converts results of the bulk operation to their proto representation
+func bulkResultsToProto(res []models.BulkResult) []*followv1.BulkResult {
+	out := make([]*followv1.BulkResult, len(res))
+	for i, v := range res {
+		out[i] = &followv1.BulkResult{
+			Target: int32(v.UUID),
+			Status: bulkStatuses[v.Status],
+		}
+	}
+
+	return out
+}
+
+// followingsToProto converts followings to the uuids list and the list of proto followings
+func followingsToProto(list []models.Following) ([]int32, []*followv1.Following) {
+	uuids := make([]int32, len(list))
+	followings := make([]*followv1.Following, len(list))
+
+	for i, v := range list {
+		uuids[i] = int32(v.UUID)
+		followings[i] = &followv1.Following{Uuid: int32(v.UUID)}
+		if !v.CreatedAt.IsZero() {
+			followings[i].FollowedAt = timestamppb.New(v.CreatedAt)
+		}
+	}
+
+	return uuids, followings
 }
 
 // int32ToInt converts list of int32 values to slice of int
@@ -0,0 +1,139 @@
+package grpcfllw
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/IlianBuh/Follow_Service/internal/domain/models"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+type Idempotency interface {
+	Outcome(ctx context.Context, caller int, key, fingerprint string) (models.IdempotencyKey, bool, error)
+	Save(ctx context.Context, caller int, outcome models.IdempotencyKey) (models.IdempotencyKey, error)
+}
+
+// idempotencyKeyField is the name of the request field with the idempotency key
+const idempotencyKeyField = "idempotency_key"
+
+// transientCodes are codes of failures which are not saved as the outcome, so retries are executed again
+var transientCodes = map[codes.Code]bool{
+	codes.Unknown:           true,
+	codes.Internal:          true,
+	codes.Unavailable:       true,
+	codes.Canceled:          true,
+	codes.DeadlineExceeded:  true,
+	codes.ResourceExhausted: true,
+}
+
+// idempotent executes 'call' once per idempotency key of the caller and replays its outcome
+// into 'resp' for the repeated requests with the key. Empty key means the request is not idempotent
+func idempotent[T proto.Message](
+	ctx context.Context,
+	idmp Idempotency,
+	caller int,
+	key string,
+	req proto.Message,
+	resp T,
+	call func() (T, error),
+) (T, error) {
+	var zero T
+	if key == "" {
+		return call()
+	}
+
+	fingerprint, err := requestFingerprint(req)
+	if err != nil {
+		return zero, toStatus(err)
+	}
+
+	outcome, ok, err := idmp.Outcome(ctx, caller, key, fingerprint)
+	if err != nil {
+		return zero, toStatus(err)
+	}
+	if ok {
+		return replay(outcome, resp)
+	}
+
+	res, callErr := call()
+	if transientCodes[status.Code(callErr)] {
+		return res, callErr
+	}
+
+	outcome, err = newOutcome(key, fingerprint, res, callErr)
+	if err != nil {
+		return zero, toStatus(err)
+	}
+
+	// the outcome is saved even if the client has gone, so its retry is replayed
+	saved, err := idmp.Save(context.WithoutCancel(ctx), caller, outcome)
+	if err != nil {
+		// the request is done, so its result is passed even if it can't be replayed
+		return res, callErr
+	}
+
+	return replay(saved, resp)
+}
+
+// requestFingerprint returns hash of the request without the idempotency key
+func requestFingerprint(req proto.Message) (string, error) {
+	req = proto.Clone(req)
+	msg := req.ProtoReflect()
+	if fd := msg.Descriptor().Fields().ByName(idempotencyKeyField); fd != nil {
+		msg.Clear(fd)
+	}
+
+	raw, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.New()
+	hash.Write([]byte(msg.Descriptor().FullName()))
+	hash.Write(raw)
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// newOutcome serializes result of the request
+func newOutcome[T proto.Message](key, fingerprint string, res T, callErr error) (models.IdempotencyKey, error) {
+	outcome := models.IdempotencyKey{Key: key, Fingerprint: fingerprint}
+
+	var err error
+	outcome.Status, err = proto.Marshal(status.Convert(callErr).Proto())
+	if err != nil {
+		return models.IdempotencyKey{}, err
+	}
+	if callErr != nil {
+		return outcome, nil
+	}
+
+	outcome.Response, err = proto.Marshal(res)
+	if err != nil {
+		return models.IdempotencyKey{}, err
+	}
+
+	return outcome, nil
+}
+
+// replay deserializes result of the request into 'resp'
+func replay[T proto.Message](outcome models.IdempotencyKey, resp T) (T, error) {
+	var zero T
+
+	st := &spb.Status{}
+	if err := proto.Unmarshal(outcome.Status, st); err != nil {
+		return zero, toStatus(err)
+	}
+	if st.GetCode() != int32(codes.OK) {
+		return zero, status.FromProto(st).Err()
+	}
+
+	if err := proto.Unmarshal(outcome.Response, resp); err != nil {
+		return zero, toStatus(err)
+	}
+
+	return resp, nil
+}
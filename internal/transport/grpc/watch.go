@@ -0,0 +1,57 @@
+package grpcfllw
+
+import (
+	"context"
+	"github.com/IlianBuh/Follow_Service/internal/domain/models"
+	followv1 "github.com/IlianBuh/Follow_Service/protobuf/gen/go"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type Watcher interface {
+	WatchFollows(
+		ctx context.Context,
+		uuid int,
+		resumeToken string,
+		send func(event models.FollowEvent, resumeToken string) error,
+	) error
+	WatchAllFollows(
+		ctx context.Context,
+		resumeToken string,
+		send func(event models.FollowEvent, resumeToken string) error,
+	) error
+}
+
+// followEventTypes maps types of follow events to their proto representation
+var followEventTypes = map[models.FollowEventType]followv1.FollowEventType{
+	models.FollowEventFollowed:   followv1.FollowEventType_FOLLOW_EVENT_TYPE_FOLLOWED,
+	models.FollowEventUnfollowed: followv1.FollowEventType_FOLLOW_EVENT_TYPE_UNFOLLOWED,
+}
+
+// WatchFollows is API-handler for WatchFollows method
+func (s *serverAPI) WatchFollows(
+	req *followv1.WatchFollowsRequest,
+	stream grpc.ServerStreamingServer[followv1.FollowEvent],
+) error {
+	err := s.wtch.WatchFollows(stream.Context(), int(req.GetUuid()), req.GetResumeToken(), sendEvent(stream))
+	if err != nil {
+		return toStatus(err)
+	}
+
+	return nil
+}
+
+// sendEvent returns function sending follow events to the stream
+func sendEvent(
+	stream grpc.ServerStreamingServer[followv1.FollowEvent],
+) func(event models.FollowEvent, resumeToken string) error {
+	return func(event models.FollowEvent, resumeToken string) error {
+		return stream.Send(&followv1.FollowEvent{
+			Type:        followEventTypes[event.Type],
+			Follower:    int32(event.Follower),
+			Followee:    int32(event.Followee),
+			CreatedAt:   timestamppb.New(event.CreatedAt),
+			ResumeToken: resumeToken,
+		})
+	}
+}
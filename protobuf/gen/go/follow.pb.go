@@ -0,0 +1,3825 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: follow.proto
+
+package followv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type FollowState int32
+
+const (
+	FollowState_FOLLOW_STATE_FOLLOWED  FollowState = 0
+	FollowState_FOLLOW_STATE_REQUESTED FollowState = 1
+)
+
+// Enum value maps for FollowState.
+var (
+	FollowState_name = map[int32]string{
+		0: "FOLLOW_STATE_FOLLOWED",
+		1: "FOLLOW_STATE_REQUESTED",
+	}
+	FollowState_value = map[string]int32{
+		"FOLLOW_STATE_FOLLOWED":  0,
+		"FOLLOW_STATE_REQUESTED": 1,
+	}
+)
+
+func (x FollowState) Enum() *FollowState {
+	p := new(FollowState)
+	*p = x
+	return p
+}
+
+func (x FollowState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FollowState) Descriptor() protoreflect.EnumDescriptor {
+	return file_follow_proto_enumTypes[0].Descriptor()
+}
+
+func (FollowState) Type() protoreflect.EnumType {
+	return &file_follow_proto_enumTypes[0]
+}
+
+func (x FollowState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FollowState.Descriptor instead.
+func (FollowState) EnumDescriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{0}
+}
+
+type Order int32
+
+const (
+	Order_ORDER_OLDEST_FIRST Order = 0
+	Order_ORDER_NEWEST_FIRST Order = 1
+)
+
+// Enum value maps for Order.
+var (
+	Order_name = map[int32]string{
+		0: "ORDER_OLDEST_FIRST",
+		1: "ORDER_NEWEST_FIRST",
+	}
+	Order_value = map[string]int32{
+		"ORDER_OLDEST_FIRST": 0,
+		"ORDER_NEWEST_FIRST": 1,
+	}
+)
+
+func (x Order) Enum() *Order {
+	p := new(Order)
+	*p = x
+	return p
+}
+
+func (x Order) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Order) Descriptor() protoreflect.EnumDescriptor {
+	return file_follow_proto_enumTypes[1].Descriptor()
+}
+
+func (Order) Type() protoreflect.EnumType {
+	return &file_follow_proto_enumTypes[1]
+}
+
+func (x Order) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Order.Descriptor instead.
+func (Order) EnumDescriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{1}
+}
+
+type BulkStatus int32
+
+const (
+	BulkStatus_BULK_STATUS_FOLLOWED          BulkStatus = 0
+	BulkStatus_BULK_STATUS_REQUESTED         BulkStatus = 1
+	BulkStatus_BULK_STATUS_ALREADY_FOLLOWING BulkStatus = 2
+	BulkStatus_BULK_STATUS_ALREADY_REQUESTED BulkStatus = 3
+	BulkStatus_BULK_STATUS_BLOCKED           BulkStatus = 4
+	BulkStatus_BULK_STATUS_INVALID_USER      BulkStatus = 5
+	BulkStatus_BULK_STATUS_UNFOLLOWED        BulkStatus = 6
+	BulkStatus_BULK_STATUS_NOT_FOLLOWING     BulkStatus = 7
+)
+
+// Enum value maps for BulkStatus.
+var (
+	BulkStatus_name = map[int32]string{
+		0: "BULK_STATUS_FOLLOWED",
+		1: "BULK_STATUS_REQUESTED",
+		2: "BULK_STATUS_ALREADY_FOLLOWING",
+		3: "BULK_STATUS_ALREADY_REQUESTED",
+		4: "BULK_STATUS_BLOCKED",
+		5: "BULK_STATUS_INVALID_USER",
+		6: "BULK_STATUS_UNFOLLOWED",
+		7: "BULK_STATUS_NOT_FOLLOWING",
+	}
+	BulkStatus_value = map[string]int32{
+		"BULK_STATUS_FOLLOWED":          0,
+		"BULK_STATUS_REQUESTED":         1,
+		"BULK_STATUS_ALREADY_FOLLOWING": 2,
+		"BULK_STATUS_ALREADY_REQUESTED": 3,
+		"BULK_STATUS_BLOCKED":           4,
+		"BULK_STATUS_INVALID_USER":      5,
+		"BULK_STATUS_UNFOLLOWED":        6,
+		"BULK_STATUS_NOT_FOLLOWING":     7,
+	}
+)
+
+func (x BulkStatus) Enum() *BulkStatus {
+	p := new(BulkStatus)
+	*p = x
+	return p
+}
+
+func (x BulkStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BulkStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_follow_proto_enumTypes[2].Descriptor()
+}
+
+func (BulkStatus) Type() protoreflect.EnumType {
+	return &file_follow_proto_enumTypes[2]
+}
+
+func (x BulkStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BulkStatus.Descriptor instead.
+func (BulkStatus) EnumDescriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{2}
+}
+
+type FollowEventType int32
+
+const (
+	FollowEventType_FOLLOW_EVENT_TYPE_FOLLOWED   FollowEventType = 0
+	FollowEventType_FOLLOW_EVENT_TYPE_UNFOLLOWED FollowEventType = 1
+)
+
+// Enum value maps for FollowEventType.
+var (
+	FollowEventType_name = map[int32]string{
+		0: "FOLLOW_EVENT_TYPE_FOLLOWED",
+		1: "FOLLOW_EVENT_TYPE_UNFOLLOWED",
+	}
+	FollowEventType_value = map[string]int32{
+		"FOLLOW_EVENT_TYPE_FOLLOWED":   0,
+		"FOLLOW_EVENT_TYPE_UNFOLLOWED": 1,
+	}
+)
+
+func (x FollowEventType) Enum() *FollowEventType {
+	p := new(FollowEventType)
+	*p = x
+	return p
+}
+
+func (x FollowEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FollowEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_follow_proto_enumTypes[3].Descriptor()
+}
+
+func (FollowEventType) Type() protoreflect.EnumType {
+	return &file_follow_proto_enumTypes[3]
+}
+
+func (x FollowEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FollowEventType.Descriptor instead.
+func (FollowEventType) EnumDescriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{3}
+}
+
+type FollowRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Src            int32                  `protobuf:"varint,1,opt,name=src,proto3" json:"src,omitempty"`
+	Target         int32                  `protobuf:"varint,2,opt,name=target,proto3" json:"target,omitempty"`
+	IdempotencyKey string                 `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *FollowRequest) Reset() {
+	*x = FollowRequest{}
+	mi := &file_follow_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowRequest) ProtoMessage() {}
+
+func (x *FollowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowRequest.ProtoReflect.Descriptor instead.
+func (*FollowRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FollowRequest) GetSrc() int32 {
+	if x != nil {
+		return x.Src
+	}
+	return 0
+}
+
+func (x *FollowRequest) GetTarget() int32 {
+	if x != nil {
+		return x.Target
+	}
+	return 0
+}
+
+func (x *FollowRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type FollowResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         FollowState            `protobuf:"varint,1,opt,name=state,proto3,enum=follow.FollowState" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowResponse) Reset() {
+	*x = FollowResponse{}
+	mi := &file_follow_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowResponse) ProtoMessage() {}
+
+func (x *FollowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowResponse.ProtoReflect.Descriptor instead.
+func (*FollowResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FollowResponse) GetState() FollowState {
+	if x != nil {
+		return x.State
+	}
+	return FollowState_FOLLOW_STATE_FOLLOWED
+}
+
+type UnfollowRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Src            int32                  `protobuf:"varint,1,opt,name=src,proto3" json:"src,omitempty"`
+	Target         int32                  `protobuf:"varint,2,opt,name=target,proto3" json:"target,omitempty"`
+	Strict         bool                   `protobuf:"varint,3,opt,name=strict,proto3" json:"strict,omitempty"`
+	IdempotencyKey string                 `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UnfollowRequest) Reset() {
+	*x = UnfollowRequest{}
+	mi := &file_follow_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnfollowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfollowRequest) ProtoMessage() {}
+
+func (x *UnfollowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfollowRequest.ProtoReflect.Descriptor instead.
+func (*UnfollowRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UnfollowRequest) GetSrc() int32 {
+	if x != nil {
+		return x.Src
+	}
+	return 0
+}
+
+func (x *UnfollowRequest) GetTarget() int32 {
+	if x != nil {
+		return x.Target
+	}
+	return 0
+}
+
+func (x *UnfollowRequest) GetStrict() bool {
+	if x != nil {
+		return x.Strict
+	}
+	return false
+}
+
+func (x *UnfollowRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type UnfollowResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Unfollowed    bool                   `protobuf:"varint,1,opt,name=unfollowed,proto3" json:"unfollowed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnfollowResponse) Reset() {
+	*x = UnfollowResponse{}
+	mi := &file_follow_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnfollowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfollowResponse) ProtoMessage() {}
+
+func (x *UnfollowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfollowResponse.ProtoReflect.Descriptor instead.
+func (*UnfollowResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UnfollowResponse) GetUnfollowed() bool {
+	if x != nil {
+		return x.Unfollowed
+	}
+	return false
+}
+
+type ListFollowersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          int32                  `protobuf:"varint,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Order         Order                  `protobuf:"varint,4,opt,name=order,proto3,enum=follow.Order" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFollowersRequest) Reset() {
+	*x = ListFollowersRequest{}
+	mi := &file_follow_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFollowersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowersRequest) ProtoMessage() {}
+
+func (x *ListFollowersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowersRequest.ProtoReflect.Descriptor instead.
+func (*ListFollowersRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListFollowersRequest) GetUuid() int32 {
+	if x != nil {
+		return x.Uuid
+	}
+	return 0
+}
+
+func (x *ListFollowersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListFollowersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListFollowersRequest) GetOrder() Order {
+	if x != nil {
+		return x.Order
+	}
+	return Order_ORDER_OLDEST_FIRST
+}
+
+type ListFollowersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuids         []int32                `protobuf:"varint,1,rep,packed,name=uuids,proto3" json:"uuids,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Followings    []*Following           `protobuf:"bytes,3,rep,name=followings,proto3" json:"followings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFollowersResponse) Reset() {
+	*x = ListFollowersResponse{}
+	mi := &file_follow_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFollowersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowersResponse) ProtoMessage() {}
+
+func (x *ListFollowersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowersResponse.ProtoReflect.Descriptor instead.
+func (*ListFollowersResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListFollowersResponse) GetUuids() []int32 {
+	if x != nil {
+		return x.Uuids
+	}
+	return nil
+}
+
+func (x *ListFollowersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListFollowersResponse) GetFollowings() []*Following {
+	if x != nil {
+		return x.Followings
+	}
+	return nil
+}
+
+type ListFolloweesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          int32                  `protobuf:"varint,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Order         Order                  `protobuf:"varint,4,opt,name=order,proto3,enum=follow.Order" json:"order,omitempty"`
+	ExcludeMuted  bool                   `protobuf:"varint,5,opt,name=exclude_muted,json=excludeMuted,proto3" json:"exclude_muted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFolloweesRequest) Reset() {
+	*x = ListFolloweesRequest{}
+	mi := &file_follow_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFolloweesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFolloweesRequest) ProtoMessage() {}
+
+func (x *ListFolloweesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFolloweesRequest.ProtoReflect.Descriptor instead.
+func (*ListFolloweesRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListFolloweesRequest) GetUuid() int32 {
+	if x != nil {
+		return x.Uuid
+	}
+	return 0
+}
+
+func (x *ListFolloweesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListFolloweesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListFolloweesRequest) GetOrder() Order {
+	if x != nil {
+		return x.Order
+	}
+	return Order_ORDER_OLDEST_FIRST
+}
+
+func (x *ListFolloweesRequest) GetExcludeMuted() bool {
+	if x != nil {
+		return x.ExcludeMuted
+	}
+	return false
+}
+
+type ListFolloweesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuids         []int32                `protobuf:"varint,1,rep,packed,name=uuids,proto3" json:"uuids,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Followings    []*Following           `protobuf:"bytes,3,rep,name=followings,proto3" json:"followings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFolloweesResponse) Reset() {
+	*x = ListFolloweesResponse{}
+	mi := &file_follow_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFolloweesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFolloweesResponse) ProtoMessage() {}
+
+func (x *ListFolloweesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFolloweesResponse.ProtoReflect.Descriptor instead.
+func (*ListFolloweesResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListFolloweesResponse) GetUuids() []int32 {
+	if x != nil {
+		return x.Uuids
+	}
+	return nil
+}
+
+func (x *ListFolloweesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListFolloweesResponse) GetFollowings() []*Following {
+	if x != nil {
+		return x.Followings
+	}
+	return nil
+}
+
+type Following struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          int32                  `protobuf:"varint,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	FollowedAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=followed_at,json=followedAt,proto3" json:"followed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Following) Reset() {
+	*x = Following{}
+	mi := &file_follow_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Following) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Following) ProtoMessage() {}
+
+func (x *Following) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Following.ProtoReflect.Descriptor instead.
+func (*Following) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Following) GetUuid() int32 {
+	if x != nil {
+		return x.Uuid
+	}
+	return 0
+}
+
+func (x *Following) GetFollowedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FollowedAt
+	}
+	return nil
+}
+
+type GetCommonFollowersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Viewer        int32                  `protobuf:"varint,1,opt,name=viewer,proto3" json:"viewer,omitempty"`
+	Target        int32                  `protobuf:"varint,2,opt,name=target,proto3" json:"target,omitempty"`
+	SampleSize    int32                  `protobuf:"varint,3,opt,name=sample_size,json=sampleSize,proto3" json:"sample_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCommonFollowersRequest) Reset() {
+	*x = GetCommonFollowersRequest{}
+	mi := &file_follow_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommonFollowersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommonFollowersRequest) ProtoMessage() {}
+
+func (x *GetCommonFollowersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommonFollowersRequest.ProtoReflect.Descriptor instead.
+func (*GetCommonFollowersRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetCommonFollowersRequest) GetViewer() int32 {
+	if x != nil {
+		return x.Viewer
+	}
+	return 0
+}
+
+func (x *GetCommonFollowersRequest) GetTarget() int32 {
+	if x != nil {
+		return x.Target
+	}
+	return 0
+}
+
+func (x *GetCommonFollowersRequest) GetSampleSize() int32 {
+	if x != nil {
+		return x.SampleSize
+	}
+	return 0
+}
+
+type GetCommonFollowersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int64                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Sample        []int32                `protobuf:"varint,2,rep,packed,name=sample,proto3" json:"sample,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCommonFollowersResponse) Reset() {
+	*x = GetCommonFollowersResponse{}
+	mi := &file_follow_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommonFollowersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommonFollowersResponse) ProtoMessage() {}
+
+func (x *GetCommonFollowersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommonFollowersResponse.ProtoReflect.Descriptor instead.
+func (*GetCommonFollowersResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetCommonFollowersResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetCommonFollowersResponse) GetSample() []int32 {
+	if x != nil {
+		return x.Sample
+	}
+	return nil
+}
+
+type StreamFollowersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          int32                  `protobuf:"varint,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	ChunkSize     int32                  `protobuf:"varint,2,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamFollowersRequest) Reset() {
+	*x = StreamFollowersRequest{}
+	mi := &file_follow_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamFollowersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamFollowersRequest) ProtoMessage() {}
+
+func (x *StreamFollowersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamFollowersRequest.ProtoReflect.Descriptor instead.
+func (*StreamFollowersRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *StreamFollowersRequest) GetUuid() int32 {
+	if x != nil {
+		return x.Uuid
+	}
+	return 0
+}
+
+func (x *StreamFollowersRequest) GetChunkSize() int32 {
+	if x != nil {
+		return x.ChunkSize
+	}
+	return 0
+}
+
+type StreamFollowersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuids         []int32                `protobuf:"varint,1,rep,packed,name=uuids,proto3" json:"uuids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamFollowersResponse) Reset() {
+	*x = StreamFollowersResponse{}
+	mi := &file_follow_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamFollowersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamFollowersResponse) ProtoMessage() {}
+
+func (x *StreamFollowersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamFollowersResponse.ProtoReflect.Descriptor instead.
+func (*StreamFollowersResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *StreamFollowersResponse) GetUuids() []int32 {
+	if x != nil {
+		return x.Uuids
+	}
+	return nil
+}
+
+type StreamFolloweesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          int32                  `protobuf:"varint,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	ChunkSize     int32                  `protobuf:"varint,2,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamFolloweesRequest) Reset() {
+	*x = StreamFolloweesRequest{}
+	mi := &file_follow_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamFolloweesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamFolloweesRequest) ProtoMessage() {}
+
+func (x *StreamFolloweesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamFolloweesRequest.ProtoReflect.Descriptor instead.
+func (*StreamFolloweesRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *StreamFolloweesRequest) GetUuid() int32 {
+	if x != nil {
+		return x.Uuid
+	}
+	return 0
+}
+
+func (x *StreamFolloweesRequest) GetChunkSize() int32 {
+	if x != nil {
+		return x.ChunkSize
+	}
+	return 0
+}
+
+type StreamFolloweesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuids         []int32                `protobuf:"varint,1,rep,packed,name=uuids,proto3" json:"uuids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamFolloweesResponse) Reset() {
+	*x = StreamFolloweesResponse{}
+	mi := &file_follow_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamFolloweesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamFolloweesResponse) ProtoMessage() {}
+
+func (x *StreamFolloweesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamFolloweesResponse.ProtoReflect.Descriptor instead.
+func (*StreamFolloweesResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *StreamFolloweesResponse) GetUuids() []int32 {
+	if x != nil {
+		return x.Uuids
+	}
+	return nil
+}
+
+type CountFollowersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          int32                  `protobuf:"varint,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountFollowersRequest) Reset() {
+	*x = CountFollowersRequest{}
+	mi := &file_follow_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountFollowersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountFollowersRequest) ProtoMessage() {}
+
+func (x *CountFollowersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountFollowersRequest.ProtoReflect.Descriptor instead.
+func (*CountFollowersRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CountFollowersRequest) GetUuid() int32 {
+	if x != nil {
+		return x.Uuid
+	}
+	return 0
+}
+
+type CountFollowersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int64                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountFollowersResponse) Reset() {
+	*x = CountFollowersResponse{}
+	mi := &file_follow_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountFollowersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountFollowersResponse) ProtoMessage() {}
+
+func (x *CountFollowersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountFollowersResponse.ProtoReflect.Descriptor instead.
+func (*CountFollowersResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CountFollowersResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type CountFolloweesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          int32                  `protobuf:"varint,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountFolloweesRequest) Reset() {
+	*x = CountFolloweesRequest{}
+	mi := &file_follow_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountFolloweesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountFolloweesRequest) ProtoMessage() {}
+
+func (x *CountFolloweesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountFolloweesRequest.ProtoReflect.Descriptor instead.
+func (*CountFolloweesRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CountFolloweesRequest) GetUuid() int32 {
+	if x != nil {
+		return x.Uuid
+	}
+	return 0
+}
+
+type CountFolloweesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int64                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountFolloweesResponse) Reset() {
+	*x = CountFolloweesResponse{}
+	mi := &file_follow_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountFolloweesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountFolloweesResponse) ProtoMessage() {}
+
+func (x *CountFolloweesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountFolloweesResponse.ProtoReflect.Descriptor instead.
+func (*CountFolloweesResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CountFolloweesResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetRelationshipsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Viewer        int32                  `protobuf:"varint,1,opt,name=viewer,proto3" json:"viewer,omitempty"`
+	Targets       []int32                `protobuf:"varint,2,rep,packed,name=targets,proto3" json:"targets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRelationshipsRequest) Reset() {
+	*x = GetRelationshipsRequest{}
+	mi := &file_follow_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRelationshipsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelationshipsRequest) ProtoMessage() {}
+
+func (x *GetRelationshipsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelationshipsRequest.ProtoReflect.Descriptor instead.
+func (*GetRelationshipsRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetRelationshipsRequest) GetViewer() int32 {
+	if x != nil {
+		return x.Viewer
+	}
+	return 0
+}
+
+func (x *GetRelationshipsRequest) GetTargets() []int32 {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+type GetRelationshipsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Relationships []*Relationship        `protobuf:"bytes,1,rep,name=relationships,proto3" json:"relationships,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRelationshipsResponse) Reset() {
+	*x = GetRelationshipsResponse{}
+	mi := &file_follow_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRelationshipsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelationshipsResponse) ProtoMessage() {}
+
+func (x *GetRelationshipsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelationshipsResponse.ProtoReflect.Descriptor instead.
+func (*GetRelationshipsResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetRelationshipsResponse) GetRelationships() []*Relationship {
+	if x != nil {
+		return x.Relationships
+	}
+	return nil
+}
+
+type Relationship struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          int32                  `protobuf:"varint,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Following     bool                   `protobuf:"varint,2,opt,name=following,proto3" json:"following,omitempty"`
+	FollowedBy    bool                   `protobuf:"varint,3,opt,name=followed_by,json=followedBy,proto3" json:"followed_by,omitempty"`
+	Mutual        bool                   `protobuf:"varint,4,opt,name=mutual,proto3" json:"mutual,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Relationship) Reset() {
+	*x = Relationship{}
+	mi := &file_follow_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Relationship) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Relationship) ProtoMessage() {}
+
+func (x *Relationship) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Relationship.ProtoReflect.Descriptor instead.
+func (*Relationship) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Relationship) GetUuid() int32 {
+	if x != nil {
+		return x.Uuid
+	}
+	return 0
+}
+
+func (x *Relationship) GetFollowing() bool {
+	if x != nil {
+		return x.Following
+	}
+	return false
+}
+
+func (x *Relationship) GetFollowedBy() bool {
+	if x != nil {
+		return x.FollowedBy
+	}
+	return false
+}
+
+func (x *Relationship) GetMutual() bool {
+	if x != nil {
+		return x.Mutual
+	}
+	return false
+}
+
+type BlockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Src           int32                  `protobuf:"varint,1,opt,name=src,proto3" json:"src,omitempty"`
+	Target        int32                  `protobuf:"varint,2,opt,name=target,proto3" json:"target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockRequest) Reset() {
+	*x = BlockRequest{}
+	mi := &file_follow_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockRequest) ProtoMessage() {}
+
+func (x *BlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockRequest.ProtoReflect.Descriptor instead.
+func (*BlockRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *BlockRequest) GetSrc() int32 {
+	if x != nil {
+		return x.Src
+	}
+	return 0
+}
+
+func (x *BlockRequest) GetTarget() int32 {
+	if x != nil {
+		return x.Target
+	}
+	return 0
+}
+
+type BlockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockResponse) Reset() {
+	*x = BlockResponse{}
+	mi := &file_follow_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockResponse) ProtoMessage() {}
+
+func (x *BlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockResponse.ProtoReflect.Descriptor instead.
+func (*BlockResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{23}
+}
+
+type UnblockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Src           int32                  `protobuf:"varint,1,opt,name=src,proto3" json:"src,omitempty"`
+	Target        int32                  `protobuf:"varint,2,opt,name=target,proto3" json:"target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnblockRequest) Reset() {
+	*x = UnblockRequest{}
+	mi := &file_follow_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnblockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockRequest) ProtoMessage() {}
+
+func (x *UnblockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockRequest.ProtoReflect.Descriptor instead.
+func (*UnblockRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UnblockRequest) GetSrc() int32 {
+	if x != nil {
+		return x.Src
+	}
+	return 0
+}
+
+func (x *UnblockRequest) GetTarget() int32 {
+	if x != nil {
+		return x.Target
+	}
+	return 0
+}
+
+type UnblockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnblockResponse) Reset() {
+	*x = UnblockResponse{}
+	mi := &file_follow_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnblockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockResponse) ProtoMessage() {}
+
+func (x *UnblockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockResponse.ProtoReflect.Descriptor instead.
+func (*UnblockResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{25}
+}
+
+type ListBlockedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          int32                  `protobuf:"varint,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBlockedRequest) Reset() {
+	*x = ListBlockedRequest{}
+	mi := &file_follow_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlockedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlockedRequest) ProtoMessage() {}
+
+func (x *ListBlockedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlockedRequest.ProtoReflect.Descriptor instead.
+func (*ListBlockedRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListBlockedRequest) GetUuid() int32 {
+	if x != nil {
+		return x.Uuid
+	}
+	return 0
+}
+
+func (x *ListBlockedRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListBlockedRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListBlockedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuids         []int32                `protobuf:"varint,1,rep,packed,name=uuids,proto3" json:"uuids,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBlockedResponse) Reset() {
+	*x = ListBlockedResponse{}
+	mi := &file_follow_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlockedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlockedResponse) ProtoMessage() {}
+
+func (x *ListBlockedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlockedResponse.ProtoReflect.Descriptor instead.
+func (*ListBlockedResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListBlockedResponse) GetUuids() []int32 {
+	if x != nil {
+		return x.Uuids
+	}
+	return nil
+}
+
+func (x *ListBlockedResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type SetPrivacyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          int32                  `protobuf:"varint,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Private       bool                   `protobuf:"varint,2,opt,name=private,proto3" json:"private,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPrivacyRequest) Reset() {
+	*x = SetPrivacyRequest{}
+	mi := &file_follow_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPrivacyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPrivacyRequest) ProtoMessage() {}
+
+func (x *SetPrivacyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPrivacyRequest.ProtoReflect.Descriptor instead.
+func (*SetPrivacyRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SetPrivacyRequest) GetUuid() int32 {
+	if x != nil {
+		return x.Uuid
+	}
+	return 0
+}
+
+func (x *SetPrivacyRequest) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
+}
+
+type SetPrivacyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPrivacyResponse) Reset() {
+	*x = SetPrivacyResponse{}
+	mi := &file_follow_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPrivacyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPrivacyResponse) ProtoMessage() {}
+
+func (x *SetPrivacyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPrivacyResponse.ProtoReflect.Descriptor instead.
+func (*SetPrivacyResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{29}
+}
+
+type GetPrivacyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          int32                  `protobuf:"varint,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPrivacyRequest) Reset() {
+	*x = GetPrivacyRequest{}
+	mi := &file_follow_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPrivacyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrivacyRequest) ProtoMessage() {}
+
+func (x *GetPrivacyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrivacyRequest.ProtoReflect.Descriptor instead.
+func (*GetPrivacyRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetPrivacyRequest) GetUuid() int32 {
+	if x != nil {
+		return x.Uuid
+	}
+	return 0
+}
+
+type GetPrivacyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Private       bool                   `protobuf:"varint,1,opt,name=private,proto3" json:"private,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPrivacyResponse) Reset() {
+	*x = GetPrivacyResponse{}
+	mi := &file_follow_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPrivacyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrivacyResponse) ProtoMessage() {}
+
+func (x *GetPrivacyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrivacyResponse.ProtoReflect.Descriptor instead.
+func (*GetPrivacyResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetPrivacyResponse) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
+}
+
+type ListFollowRequestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          int32                  `protobuf:"varint,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFollowRequestsRequest) Reset() {
+	*x = ListFollowRequestsRequest{}
+	mi := &file_follow_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFollowRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowRequestsRequest) ProtoMessage() {}
+
+func (x *ListFollowRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListFollowRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListFollowRequestsRequest) GetUuid() int32 {
+	if x != nil {
+		return x.Uuid
+	}
+	return 0
+}
+
+func (x *ListFollowRequestsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListFollowRequestsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListFollowRequestsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Requests      []*PendingFollow       `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFollowRequestsResponse) Reset() {
+	*x = ListFollowRequestsResponse{}
+	mi := &file_follow_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFollowRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowRequestsResponse) ProtoMessage() {}
+
+func (x *ListFollowRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListFollowRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListFollowRequestsResponse) GetRequests() []*PendingFollow {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+func (x *ListFollowRequestsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type PendingFollow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          int32                  `protobuf:"varint,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	RequestedAt   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PendingFollow) Reset() {
+	*x = PendingFollow{}
+	mi := &file_follow_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PendingFollow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingFollow) ProtoMessage() {}
+
+func (x *PendingFollow) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PendingFollow.ProtoReflect.Descriptor instead.
+func (*PendingFollow) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *PendingFollow) GetUuid() int32 {
+	if x != nil {
+		return x.Uuid
+	}
+	return 0
+}
+
+func (x *PendingFollow) GetRequestedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return nil
+}
+
+type ApproveFollowRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          int32                  `protobuf:"varint,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Requester     int32                  `protobuf:"varint,2,opt,name=requester,proto3" json:"requester,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveFollowRequestRequest) Reset() {
+	*x = ApproveFollowRequestRequest{}
+	mi := &file_follow_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveFollowRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveFollowRequestRequest) ProtoMessage() {}
+
+func (x *ApproveFollowRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveFollowRequestRequest.ProtoReflect.Descriptor instead.
+func (*ApproveFollowRequestRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ApproveFollowRequestRequest) GetUuid() int32 {
+	if x != nil {
+		return x.Uuid
+	}
+	return 0
+}
+
+func (x *ApproveFollowRequestRequest) GetRequester() int32 {
+	if x != nil {
+		return x.Requester
+	}
+	return 0
+}
+
+type ApproveFollowRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveFollowRequestResponse) Reset() {
+	*x = ApproveFollowRequestResponse{}
+	mi := &file_follow_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveFollowRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveFollowRequestResponse) ProtoMessage() {}
+
+func (x *ApproveFollowRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveFollowRequestResponse.ProtoReflect.Descriptor instead.
+func (*ApproveFollowRequestResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{36}
+}
+
+type RejectFollowRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          int32                  `protobuf:"varint,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Requester     int32                  `protobuf:"varint,2,opt,name=requester,proto3" json:"requester,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectFollowRequestRequest) Reset() {
+	*x = RejectFollowRequestRequest{}
+	mi := &file_follow_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectFollowRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectFollowRequestRequest) ProtoMessage() {}
+
+func (x *RejectFollowRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectFollowRequestRequest.ProtoReflect.Descriptor instead.
+func (*RejectFollowRequestRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RejectFollowRequestRequest) GetUuid() int32 {
+	if x != nil {
+		return x.Uuid
+	}
+	return 0
+}
+
+func (x *RejectFollowRequestRequest) GetRequester() int32 {
+	if x != nil {
+		return x.Requester
+	}
+	return 0
+}
+
+type RejectFollowRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectFollowRequestResponse) Reset() {
+	*x = RejectFollowRequestResponse{}
+	mi := &file_follow_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectFollowRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectFollowRequestResponse) ProtoMessage() {}
+
+func (x *RejectFollowRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectFollowRequestResponse.ProtoReflect.Descriptor instead.
+func (*RejectFollowRequestResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{38}
+}
+
+type MuteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Src           int32                  `protobuf:"varint,1,opt,name=src,proto3" json:"src,omitempty"`
+	Target        int32                  `protobuf:"varint,2,opt,name=target,proto3" json:"target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MuteRequest) Reset() {
+	*x = MuteRequest{}
+	mi := &file_follow_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MuteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteRequest) ProtoMessage() {}
+
+func (x *MuteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteRequest.ProtoReflect.Descriptor instead.
+func (*MuteRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *MuteRequest) GetSrc() int32 {
+	if x != nil {
+		return x.Src
+	}
+	return 0
+}
+
+func (x *MuteRequest) GetTarget() int32 {
+	if x != nil {
+		return x.Target
+	}
+	return 0
+}
+
+type MuteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MuteResponse) Reset() {
+	*x = MuteResponse{}
+	mi := &file_follow_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MuteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteResponse) ProtoMessage() {}
+
+func (x *MuteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteResponse.ProtoReflect.Descriptor instead.
+func (*MuteResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{40}
+}
+
+type UnmuteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Src           int32                  `protobuf:"varint,1,opt,name=src,proto3" json:"src,omitempty"`
+	Target        int32                  `protobuf:"varint,2,opt,name=target,proto3" json:"target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnmuteRequest) Reset() {
+	*x = UnmuteRequest{}
+	mi := &file_follow_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnmuteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmuteRequest) ProtoMessage() {}
+
+func (x *UnmuteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmuteRequest.ProtoReflect.Descriptor instead.
+func (*UnmuteRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UnmuteRequest) GetSrc() int32 {
+	if x != nil {
+		return x.Src
+	}
+	return 0
+}
+
+func (x *UnmuteRequest) GetTarget() int32 {
+	if x != nil {
+		return x.Target
+	}
+	return 0
+}
+
+type UnmuteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnmuteResponse) Reset() {
+	*x = UnmuteResponse{}
+	mi := &file_follow_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnmuteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmuteResponse) ProtoMessage() {}
+
+func (x *UnmuteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmuteResponse.ProtoReflect.Descriptor instead.
+func (*UnmuteResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{42}
+}
+
+type ListMutualsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          int32                  `protobuf:"varint,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMutualsRequest) Reset() {
+	*x = ListMutualsRequest{}
+	mi := &file_follow_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMutualsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMutualsRequest) ProtoMessage() {}
+
+func (x *ListMutualsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMutualsRequest.ProtoReflect.Descriptor instead.
+func (*ListMutualsRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListMutualsRequest) GetUuid() int32 {
+	if x != nil {
+		return x.Uuid
+	}
+	return 0
+}
+
+func (x *ListMutualsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMutualsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListMutualsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuids         []int32                `protobuf:"varint,1,rep,packed,name=uuids,proto3" json:"uuids,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Followings    []*Following           `protobuf:"bytes,3,rep,name=followings,proto3" json:"followings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMutualsResponse) Reset() {
+	*x = ListMutualsResponse{}
+	mi := &file_follow_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMutualsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMutualsResponse) ProtoMessage() {}
+
+func (x *ListMutualsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMutualsResponse.ProtoReflect.Descriptor instead.
+func (*ListMutualsResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListMutualsResponse) GetUuids() []int32 {
+	if x != nil {
+		return x.Uuids
+	}
+	return nil
+}
+
+func (x *ListMutualsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListMutualsResponse) GetFollowings() []*Following {
+	if x != nil {
+		return x.Followings
+	}
+	return nil
+}
+
+type CountMutualsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          int32                  `protobuf:"varint,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountMutualsRequest) Reset() {
+	*x = CountMutualsRequest{}
+	mi := &file_follow_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountMutualsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountMutualsRequest) ProtoMessage() {}
+
+func (x *CountMutualsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountMutualsRequest.ProtoReflect.Descriptor instead.
+func (*CountMutualsRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CountMutualsRequest) GetUuid() int32 {
+	if x != nil {
+		return x.Uuid
+	}
+	return 0
+}
+
+type CountMutualsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int64                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountMutualsResponse) Reset() {
+	*x = CountMutualsResponse{}
+	mi := &file_follow_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountMutualsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountMutualsResponse) ProtoMessage() {}
+
+func (x *CountMutualsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountMutualsResponse.ProtoReflect.Descriptor instead.
+func (*CountMutualsResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CountMutualsResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type SuggestFollowsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          int32                  `protobuf:"varint,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestFollowsRequest) Reset() {
+	*x = SuggestFollowsRequest{}
+	mi := &file_follow_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestFollowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestFollowsRequest) ProtoMessage() {}
+
+func (x *SuggestFollowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestFollowsRequest.ProtoReflect.Descriptor instead.
+func (*SuggestFollowsRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SuggestFollowsRequest) GetUuid() int32 {
+	if x != nil {
+		return x.Uuid
+	}
+	return 0
+}
+
+func (x *SuggestFollowsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SuggestFollowsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suggestions   []*Suggestion          `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestFollowsResponse) Reset() {
+	*x = SuggestFollowsResponse{}
+	mi := &file_follow_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestFollowsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestFollowsResponse) ProtoMessage() {}
+
+func (x *SuggestFollowsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestFollowsResponse.ProtoReflect.Descriptor instead.
+func (*SuggestFollowsResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SuggestFollowsResponse) GetSuggestions() []*Suggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+type Suggestion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          int32                  `protobuf:"varint,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Paths         int32                  `protobuf:"varint,2,opt,name=paths,proto3" json:"paths,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Suggestion) Reset() {
+	*x = Suggestion{}
+	mi := &file_follow_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Suggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Suggestion) ProtoMessage() {}
+
+func (x *Suggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Suggestion.ProtoReflect.Descriptor instead.
+func (*Suggestion) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *Suggestion) GetUuid() int32 {
+	if x != nil {
+		return x.Uuid
+	}
+	return 0
+}
+
+func (x *Suggestion) GetPaths() int32 {
+	if x != nil {
+		return x.Paths
+	}
+	return 0
+}
+
+type FollowPathRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Src           int32                  `protobuf:"varint,1,opt,name=src,proto3" json:"src,omitempty"`
+	Dst           int32                  `protobuf:"varint,2,opt,name=dst,proto3" json:"dst,omitempty"`
+	MaxDepth      int32                  `protobuf:"varint,3,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowPathRequest) Reset() {
+	*x = FollowPathRequest{}
+	mi := &file_follow_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowPathRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowPathRequest) ProtoMessage() {}
+
+func (x *FollowPathRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowPathRequest.ProtoReflect.Descriptor instead.
+func (*FollowPathRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *FollowPathRequest) GetSrc() int32 {
+	if x != nil {
+		return x.Src
+	}
+	return 0
+}
+
+func (x *FollowPathRequest) GetDst() int32 {
+	if x != nil {
+		return x.Dst
+	}
+	return 0
+}
+
+func (x *FollowPathRequest) GetMaxDepth() int32 {
+	if x != nil {
+		return x.MaxDepth
+	}
+	return 0
+}
+
+type FollowPathResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          []int32                `protobuf:"varint,1,rep,packed,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowPathResponse) Reset() {
+	*x = FollowPathResponse{}
+	mi := &file_follow_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowPathResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowPathResponse) ProtoMessage() {}
+
+func (x *FollowPathResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowPathResponse.ProtoReflect.Descriptor instead.
+func (*FollowPathResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *FollowPathResponse) GetPath() []int32 {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+type BulkFollowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Src           int32                  `protobuf:"varint,1,opt,name=src,proto3" json:"src,omitempty"`
+	Targets       []int32                `protobuf:"varint,2,rep,packed,name=targets,proto3" json:"targets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkFollowRequest) Reset() {
+	*x = BulkFollowRequest{}
+	mi := &file_follow_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkFollowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkFollowRequest) ProtoMessage() {}
+
+func (x *BulkFollowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkFollowRequest.ProtoReflect.Descriptor instead.
+func (*BulkFollowRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *BulkFollowRequest) GetSrc() int32 {
+	if x != nil {
+		return x.Src
+	}
+	return 0
+}
+
+func (x *BulkFollowRequest) GetTargets() []int32 {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+type BulkFollowResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*BulkResult          `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkFollowResponse) Reset() {
+	*x = BulkFollowResponse{}
+	mi := &file_follow_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkFollowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkFollowResponse) ProtoMessage() {}
+
+func (x *BulkFollowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkFollowResponse.ProtoReflect.Descriptor instead.
+func (*BulkFollowResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *BulkFollowResponse) GetResults() []*BulkResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type BulkUnfollowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Src           int32                  `protobuf:"varint,1,opt,name=src,proto3" json:"src,omitempty"`
+	Targets       []int32                `protobuf:"varint,2,rep,packed,name=targets,proto3" json:"targets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkUnfollowRequest) Reset() {
+	*x = BulkUnfollowRequest{}
+	mi := &file_follow_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUnfollowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUnfollowRequest) ProtoMessage() {}
+
+func (x *BulkUnfollowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUnfollowRequest.ProtoReflect.Descriptor instead.
+func (*BulkUnfollowRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *BulkUnfollowRequest) GetSrc() int32 {
+	if x != nil {
+		return x.Src
+	}
+	return 0
+}
+
+func (x *BulkUnfollowRequest) GetTargets() []int32 {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+type BulkUnfollowResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*BulkResult          `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkUnfollowResponse) Reset() {
+	*x = BulkUnfollowResponse{}
+	mi := &file_follow_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUnfollowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUnfollowResponse) ProtoMessage() {}
+
+func (x *BulkUnfollowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUnfollowResponse.ProtoReflect.Descriptor instead.
+func (*BulkUnfollowResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *BulkUnfollowResponse) GetResults() []*BulkResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type BulkResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Target        int32                  `protobuf:"varint,1,opt,name=target,proto3" json:"target,omitempty"`
+	Status        BulkStatus             `protobuf:"varint,2,opt,name=status,proto3,enum=follow.BulkStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkResult) Reset() {
+	*x = BulkResult{}
+	mi := &file_follow_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkResult) ProtoMessage() {}
+
+func (x *BulkResult) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkResult.ProtoReflect.Descriptor instead.
+func (*BulkResult) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *BulkResult) GetTarget() int32 {
+	if x != nil {
+		return x.Target
+	}
+	return 0
+}
+
+func (x *BulkResult) GetStatus() BulkStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BulkStatus_BULK_STATUS_FOLLOWED
+}
+
+type WatchFollowsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          int32                  `protobuf:"varint,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	ResumeToken   string                 `protobuf:"bytes,2,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchFollowsRequest) Reset() {
+	*x = WatchFollowsRequest{}
+	mi := &file_follow_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchFollowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchFollowsRequest) ProtoMessage() {}
+
+func (x *WatchFollowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchFollowsRequest.ProtoReflect.Descriptor instead.
+func (*WatchFollowsRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *WatchFollowsRequest) GetUuid() int32 {
+	if x != nil {
+		return x.Uuid
+	}
+	return 0
+}
+
+func (x *WatchFollowsRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+type FollowEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          FollowEventType        `protobuf:"varint,1,opt,name=type,proto3,enum=follow.FollowEventType" json:"type,omitempty"`
+	Follower      int32                  `protobuf:"varint,2,opt,name=follower,proto3" json:"follower,omitempty"`
+	Followee      int32                  `protobuf:"varint,3,opt,name=followee,proto3" json:"followee,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ResumeToken   string                 `protobuf:"bytes,5,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowEvent) Reset() {
+	*x = FollowEvent{}
+	mi := &file_follow_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowEvent) ProtoMessage() {}
+
+func (x *FollowEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowEvent.ProtoReflect.Descriptor instead.
+func (*FollowEvent) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *FollowEvent) GetType() FollowEventType {
+	if x != nil {
+		return x.Type
+	}
+	return FollowEventType_FOLLOW_EVENT_TYPE_FOLLOWED
+}
+
+func (x *FollowEvent) GetFollower() int32 {
+	if x != nil {
+		return x.Follower
+	}
+	return 0
+}
+
+func (x *FollowEvent) GetFollowee() int32 {
+	if x != nil {
+		return x.Followee
+	}
+	return 0
+}
+
+func (x *FollowEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *FollowEvent) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+type DeleteUserGraphRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          int32                  `protobuf:"varint,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserGraphRequest) Reset() {
+	*x = DeleteUserGraphRequest{}
+	mi := &file_follow_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserGraphRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserGraphRequest) ProtoMessage() {}
+
+func (x *DeleteUserGraphRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserGraphRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserGraphRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *DeleteUserGraphRequest) GetUuid() int32 {
+	if x != nil {
+		return x.Uuid
+	}
+	return 0
+}
+
+type DeleteUserGraphResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Followers     int64                  `protobuf:"varint,1,opt,name=followers,proto3" json:"followers,omitempty"`
+	Followees     int64                  `protobuf:"varint,2,opt,name=followees,proto3" json:"followees,omitempty"`
+	Requests      int64                  `protobuf:"varint,3,opt,name=requests,proto3" json:"requests,omitempty"`
+	Blocks        int64                  `protobuf:"varint,4,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	Mutes         int64                  `protobuf:"varint,5,opt,name=mutes,proto3" json:"mutes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserGraphResponse) Reset() {
+	*x = DeleteUserGraphResponse{}
+	mi := &file_follow_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserGraphResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserGraphResponse) ProtoMessage() {}
+
+func (x *DeleteUserGraphResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserGraphResponse.ProtoReflect.Descriptor instead.
+func (*DeleteUserGraphResponse) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *DeleteUserGraphResponse) GetFollowers() int64 {
+	if x != nil {
+		return x.Followers
+	}
+	return 0
+}
+
+func (x *DeleteUserGraphResponse) GetFollowees() int64 {
+	if x != nil {
+		return x.Followees
+	}
+	return 0
+}
+
+func (x *DeleteUserGraphResponse) GetRequests() int64 {
+	if x != nil {
+		return x.Requests
+	}
+	return 0
+}
+
+func (x *DeleteUserGraphResponse) GetBlocks() int64 {
+	if x != nil {
+		return x.Blocks
+	}
+	return 0
+}
+
+func (x *DeleteUserGraphResponse) GetMutes() int64 {
+	if x != nil {
+		return x.Mutes
+	}
+	return 0
+}
+
+type WatchAllFollowsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResumeToken   string                 `protobuf:"bytes,1,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchAllFollowsRequest) Reset() {
+	*x = WatchAllFollowsRequest{}
+	mi := &file_follow_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchAllFollowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchAllFollowsRequest) ProtoMessage() {}
+
+func (x *WatchAllFollowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_follow_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchAllFollowsRequest.ProtoReflect.Descriptor instead.
+func (*WatchAllFollowsRequest) Descriptor() ([]byte, []int) {
+	return file_follow_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *WatchAllFollowsRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+var File_follow_proto protoreflect.FileDescriptor
+
+const file_follow_proto_rawDesc = "" +
+	"\n" +
+	"\ffollow.proto\x12\x06follow\x1a\x1fgoogle/protobuf/timestamp.proto\"b\n" +
+	"\rFollowRequest\x12\x10\n" +
+	"\x03src\x18\x01 \x01(\x05R\x03src\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\x05R\x06target\x12'\n" +
+	"\x0fidempotency_key\x18\x03 \x01(\tR\x0eidempotencyKey\";\n" +
+	"\x0eFollowResponse\x12)\n" +
+	"\x05state\x18\x01 \x01(\x0e2\x13.follow.FollowStateR\x05state\"|\n" +
+	"\x0fUnfollowRequest\x12\x10\n" +
+	"\x03src\x18\x01 \x01(\x05R\x03src\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\x05R\x06target\x12\x16\n" +
+	"\x06strict\x18\x03 \x01(\bR\x06strict\x12'\n" +
+	"\x0fidempotency_key\x18\x04 \x01(\tR\x0eidempotencyKey\"2\n" +
+	"\x10UnfollowResponse\x12\x1e\n" +
+	"\n" +
+	"unfollowed\x18\x01 \x01(\bR\n" +
+	"unfollowed\"\x8b\x01\n" +
+	"\x14ListFollowersRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\x05R\x04uuid\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12#\n" +
+	"\x05order\x18\x04 \x01(\x0e2\r.follow.OrderR\x05order\"\x88\x01\n" +
+	"\x15ListFollowersResponse\x12\x14\n" +
+	"\x05uuids\x18\x01 \x03(\x05R\x05uuids\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x121\n" +
+	"\n" +
+	"followings\x18\x03 \x03(\v2\x11.follow.FollowingR\n" +
+	"followings\"\xb0\x01\n" +
+	"\x14ListFolloweesRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\x05R\x04uuid\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12#\n" +
+	"\x05order\x18\x04 \x01(\x0e2\r.follow.OrderR\x05order\x12#\n" +
+	"\rexclude_muted\x18\x05 \x01(\bR\fexcludeMuted\"\x88\x01\n" +
+	"\x15ListFolloweesResponse\x12\x14\n" +
+	"\x05uuids\x18\x01 \x03(\x05R\x05uuids\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x121\n" +
+	"\n" +
+	"followings\x18\x03 \x03(\v2\x11.follow.FollowingR\n" +
+	"followings\"\\\n" +
+	"\tFollowing\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\x05R\x04uuid\x12;\n" +
+	"\vfollowed_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"followedAt\"l\n" +
+	"\x19GetCommonFollowersRequest\x12\x16\n" +
+	"\x06viewer\x18\x01 \x01(\x05R\x06viewer\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\x05R\x06target\x12\x1f\n" +
+	"\vsample_size\x18\x03 \x01(\x05R\n" +
+	"sampleSize\"J\n" +
+	"\x1aGetCommonFollowersResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x03R\x05count\x12\x16\n" +
+	"\x06sample\x18\x02 \x03(\x05R\x06sample\"K\n" +
+	"\x16StreamFollowersRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\x05R\x04uuid\x12\x1d\n" +
+	"\n" +
+	"chunk_size\x18\x02 \x01(\x05R\tchunkSize\"/\n" +
+	"\x17StreamFollowersResponse\x12\x14\n" +
+	"\x05uuids\x18\x01 \x03(\x05R\x05uuids\"K\n" +
+	"\x16StreamFolloweesRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\x05R\x04uuid\x12\x1d\n" +
+	"\n" +
+	"chunk_size\x18\x02 \x01(\x05R\tchunkSize\"/\n" +
+	"\x17StreamFolloweesResponse\x12\x14\n" +
+	"\x05uuids\x18\x01 \x03(\x05R\x05uuids\"+\n" +
+	"\x15CountFollowersRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\x05R\x04uuid\".\n" +
+	"\x16CountFollowersResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x03R\x05count\"+\n" +
+	"\x15CountFolloweesRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\x05R\x04uuid\".\n" +
+	"\x16CountFolloweesResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x03R\x05count\"K\n" +
+	"\x17GetRelationshipsRequest\x12\x16\n" +
+	"\x06viewer\x18\x01 \x01(\x05R\x06viewer\x12\x18\n" +
+	"\atargets\x18\x02 \x03(\x05R\atargets\"V\n" +
+	"\x18GetRelationshipsResponse\x12:\n" +
+	"\rrelationships\x18\x01 \x03(\v2\x14.follow.RelationshipR\rrelationships\"y\n" +
+	"\fRelationship\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\x05R\x04uuid\x12\x1c\n" +
+	"\tfollowing\x18\x02 \x01(\bR\tfollowing\x12\x1f\n" +
+	"\vfollowed_by\x18\x03 \x01(\bR\n" +
+	"followedBy\x12\x16\n" +
+	"\x06mutual\x18\x04 \x01(\bR\x06mutual\"8\n" +
+	"\fBlockRequest\x12\x10\n" +
+	"\x03src\x18\x01 \x01(\x05R\x03src\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\x05R\x06target\"\x0f\n" +
+	"\rBlockResponse\":\n" +
+	"\x0eUnblockRequest\x12\x10\n" +
+	"\x03src\x18\x01 \x01(\x05R\x03src\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\x05R\x06target\"\x11\n" +
+	"\x0fUnblockResponse\"d\n" +
+	"\x12ListBlockedRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\x05R\x04uuid\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"S\n" +
+	"\x13ListBlockedResponse\x12\x14\n" +
+	"\x05uuids\x18\x01 \x03(\x05R\x05uuids\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"A\n" +
+	"\x11SetPrivacyRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\x05R\x04uuid\x12\x18\n" +
+	"\aprivate\x18\x02 \x01(\bR\aprivate\"\x14\n" +
+	"\x12SetPrivacyResponse\"'\n" +
+	"\x11GetPrivacyRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\x05R\x04uuid\".\n" +
+	"\x12GetPrivacyResponse\x12\x18\n" +
+	"\aprivate\x18\x01 \x01(\bR\aprivate\"k\n" +
+	"\x19ListFollowRequestsRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\x05R\x04uuid\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"w\n" +
+	"\x1aListFollowRequestsResponse\x121\n" +
+	"\brequests\x18\x01 \x03(\v2\x15.follow.PendingFollowR\brequests\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"b\n" +
+	"\rPendingFollow\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\x05R\x04uuid\x12=\n" +
+	"\frequested_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vrequestedAt\"O\n" +
+	"\x1bApproveFollowRequestRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\x05R\x04uuid\x12\x1c\n" +
+	"\trequester\x18\x02 \x01(\x05R\trequester\"\x1e\n" +
+	"\x1cApproveFollowRequestResponse\"N\n" +
+	"\x1aRejectFollowRequestRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\x05R\x04uuid\x12\x1c\n" +
+	"\trequester\x18\x02 \x01(\x05R\trequester\"\x1d\n" +
+	"\x1bRejectFollowRequestResponse\"7\n" +
+	"\vMuteRequest\x12\x10\n" +
+	"\x03src\x18\x01 \x01(\x05R\x03src\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\x05R\x06target\"\x0e\n" +
+	"\fMuteResponse\"9\n" +
+	"\rUnmuteRequest\x12\x10\n" +
+	"\x03src\x18\x01 \x01(\x05R\x03src\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\x05R\x06target\"\x10\n" +
+	"\x0eUnmuteResponse\"d\n" +
+	"\x12ListMutualsRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\x05R\x04uuid\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x86\x01\n" +
+	"\x13ListMutualsResponse\x12\x14\n" +
+	"\x05uuids\x18\x01 \x03(\x05R\x05uuids\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x121\n" +
+	"\n" +
+	"followings\x18\x03 \x03(\v2\x11.follow.FollowingR\n" +
+	"followings\")\n" +
+	"\x13CountMutualsRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\x05R\x04uuid\",\n" +
+	"\x14CountMutualsResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x03R\x05count\"A\n" +
+	"\x15SuggestFollowsRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\x05R\x04uuid\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"N\n" +
+	"\x16SuggestFollowsResponse\x124\n" +
+	"\vsuggestions\x18\x01 \x03(\v2\x12.follow.SuggestionR\vsuggestions\"6\n" +
+	"\n" +
+	"Suggestion\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\x05R\x04uuid\x12\x14\n" +
+	"\x05paths\x18\x02 \x01(\x05R\x05paths\"T\n" +
+	"\x11FollowPathRequest\x12\x10\n" +
+	"\x03src\x18\x01 \x01(\x05R\x03src\x12\x10\n" +
+	"\x03dst\x18\x02 \x01(\x05R\x03dst\x12\x1b\n" +
+	"\tmax_depth\x18\x03 \x01(\x05R\bmaxDepth\"(\n" +
+	"\x12FollowPathResponse\x12\x12\n" +
+	"\x04path\x18\x01 \x03(\x05R\x04path\"?\n" +
+	"\x11BulkFollowRequest\x12\x10\n" +
+	"\x03src\x18\x01 \x01(\x05R\x03src\x12\x18\n" +
+	"\atargets\x18\x02 \x03(\x05R\atargets\"B\n" +
+	"\x12BulkFollowResponse\x12,\n" +
+	"\aresults\x18\x01 \x03(\v2\x12.follow.BulkResultR\aresults\"A\n" +
+	"\x13BulkUnfollowRequest\x12\x10\n" +
+	"\x03src\x18\x01 \x01(\x05R\x03src\x12\x18\n" +
+	"\atargets\x18\x02 \x03(\x05R\atargets\"D\n" +
+	"\x14BulkUnfollowResponse\x12,\n" +
+	"\aresults\x18\x01 \x03(\v2\x12.follow.BulkResultR\aresults\"P\n" +
+	"\n" +
+	"BulkResult\x12\x16\n" +
+	"\x06target\x18\x01 \x01(\x05R\x06target\x12*\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x12.follow.BulkStatusR\x06status\"L\n" +
+	"\x13WatchFollowsRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\x05R\x04uuid\x12!\n" +
+	"\fresume_token\x18\x02 \x01(\tR\vresumeToken\"\xd0\x01\n" +
+	"\vFollowEvent\x12+\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x17.follow.FollowEventTypeR\x04type\x12\x1a\n" +
+	"\bfollower\x18\x02 \x01(\x05R\bfollower\x12\x1a\n" +
+	"\bfollowee\x18\x03 \x01(\x05R\bfollowee\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12!\n" +
+	"\fresume_token\x18\x05 \x01(\tR\vresumeToken\",\n" +
+	"\x16DeleteUserGraphRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\x05R\x04uuid\"\x9f\x01\n" +
+	"\x17DeleteUserGraphResponse\x12\x1c\n" +
+	"\tfollowers\x18\x01 \x01(\x03R\tfollowers\x12\x1c\n" +
+	"\tfollowees\x18\x02 \x01(\x03R\tfollowees\x12\x1a\n" +
+	"\brequests\x18\x03 \x01(\x03R\brequests\x12\x16\n" +
+	"\x06blocks\x18\x04 \x01(\x03R\x06blocks\x12\x14\n" +
+	"\x05mutes\x18\x05 \x01(\x03R\x05mutes\";\n" +
+	"\x16WatchAllFollowsRequest\x12!\n" +
+	"\fresume_token\x18\x01 \x01(\tR\vresumeToken*D\n" +
+	"\vFollowState\x12\x19\n" +
+	"\x15FOLLOW_STATE_FOLLOWED\x10\x00\x12\x1a\n" +
+	"\x16FOLLOW_STATE_REQUESTED\x10\x01*7\n" +
+	"\x05Order\x12\x16\n" +
+	"\x12ORDER_OLDEST_FIRST\x10\x00\x12\x16\n" +
+	"\x12ORDER_NEWEST_FIRST\x10\x01*\xf9\x01\n" +
+	"\n" +
+	"BulkStatus\x12\x18\n" +
+	"\x14BULK_STATUS_FOLLOWED\x10\x00\x12\x19\n" +
+	"\x15BULK_STATUS_REQUESTED\x10\x01\x12!\n" +
+	"\x1dBULK_STATUS_ALREADY_FOLLOWING\x10\x02\x12!\n" +
+	"\x1dBULK_STATUS_ALREADY_REQUESTED\x10\x03\x12\x17\n" +
+	"\x13BULK_STATUS_BLOCKED\x10\x04\x12\x1c\n" +
+	"\x18BULK_STATUS_INVALID_USER\x10\x05\x12\x1a\n" +
+	"\x16BULK_STATUS_UNFOLLOWED\x10\x06\x12\x1d\n" +
+	"\x19BULK_STATUS_NOT_FOLLOWING\x10\a*S\n" +
+	"\x0fFollowEventType\x12\x1e\n" +
+	"\x1aFOLLOW_EVENT_TYPE_FOLLOWED\x10\x00\x12 \n" +
+	"\x1cFOLLOW_EVENT_TYPE_UNFOLLOWED\x10\x012\xeb\x0f\n" +
+	"\x06Follow\x127\n" +
+	"\x06Follow\x12\x15.follow.FollowRequest\x1a\x16.follow.FollowResponse\x12=\n" +
+	"\bUnfollow\x12\x17.follow.UnfollowRequest\x1a\x18.follow.UnfollowResponse\x12L\n" +
+	"\rListFollowers\x12\x1c.follow.ListFollowersRequest\x1a\x1d.follow.ListFollowersResponse\x12L\n" +
+	"\rListFollowees\x12\x1c.follow.ListFolloweesRequest\x1a\x1d.follow.ListFolloweesResponse\x12[\n" +
+	"\x12GetCommonFollowers\x12!.follow.GetCommonFollowersRequest\x1a\".follow.GetCommonFollowersResponse\x12T\n" +
+	"\x0fStreamFollowers\x12\x1e.follow.StreamFollowersRequest\x1a\x1f.follow.StreamFollowersResponse0\x01\x12T\n" +
+	"\x0fStreamFollowees\x12\x1e.follow.StreamFolloweesRequest\x1a\x1f.follow.StreamFolloweesResponse0\x01\x12O\n" +
+	"\x0eCountFollowers\x12\x1d.follow.CountFollowersRequest\x1a\x1e.follow.CountFollowersResponse\x12O\n" +
+	"\x0eCountFollowees\x12\x1d.follow.CountFolloweesRequest\x1a\x1e.follow.CountFolloweesResponse\x12U\n" +
+	"\x10GetRelationships\x12\x1f.follow.GetRelationshipsRequest\x1a .follow.GetRelationshipsResponse\x124\n" +
+	"\x05Block\x12\x14.follow.BlockRequest\x1a\x15.follow.BlockResponse\x12:\n" +
+	"\aUnblock\x12\x16.follow.UnblockRequest\x1a\x17.follow.UnblockResponse\x12F\n" +
+	"\vListBlocked\x12\x1a.follow.ListBlockedRequest\x1a\x1b.follow.ListBlockedResponse\x12C\n" +
+	"\n" +
+	"SetPrivacy\x12\x19.follow.SetPrivacyRequest\x1a\x1a.follow.SetPrivacyResponse\x12C\n" +
+	"\n" +
+	"GetPrivacy\x12\x19.follow.GetPrivacyRequest\x1a\x1a.follow.GetPrivacyResponse\x12[\n" +
+	"\x12ListFollowRequests\x12!.follow.ListFollowRequestsRequest\x1a\".follow.ListFollowRequestsResponse\x12a\n" +
+	"\x14ApproveFollowRequest\x12#.follow.ApproveFollowRequestRequest\x1a$.follow.ApproveFollowRequestResponse\x12^\n" +
+	"\x13RejectFollowRequest\x12\".follow.RejectFollowRequestRequest\x1a#.follow.RejectFollowRequestResponse\x121\n" +
+	"\x04Mute\x12\x13.follow.MuteRequest\x1a\x14.follow.MuteResponse\x127\n" +
+	"\x06Unmute\x12\x15.follow.UnmuteRequest\x1a\x16.follow.UnmuteResponse\x12F\n" +
+	"\vListMutuals\x12\x1a.follow.ListMutualsRequest\x1a\x1b.follow.ListMutualsResponse\x12I\n" +
+	"\fCountMutuals\x12\x1b.follow.CountMutualsRequest\x1a\x1c.follow.CountMutualsResponse\x12O\n" +
+	"\x0eSuggestFollows\x12\x1d.follow.SuggestFollowsRequest\x1a\x1e.follow.SuggestFollowsResponse\x12C\n" +
+	"\n" +
+	"FollowPath\x12\x19.follow.FollowPathRequest\x1a\x1a.follow.FollowPathResponse\x12C\n" +
+	"\n" +
+	"BulkFollow\x12\x19.follow.BulkFollowRequest\x1a\x1a.follow.BulkFollowResponse\x12I\n" +
+	"\fBulkUnfollow\x12\x1b.follow.BulkUnfollowRequest\x1a\x1c.follow.BulkUnfollowResponse\x12B\n" +
+	"\fWatchFollows\x12\x1b.follow.WatchFollowsRequest\x1a\x13.follow.FollowEvent0\x012\xab\x01\n" +
+	"\vFollowAdmin\x12R\n" +
+	"\x0fDeleteUserGraph\x12\x1e.follow.DeleteUserGraphRequest\x1a\x1f.follow.DeleteUserGraphResponse\x12H\n" +
+	"\x0fWatchAllFollows\x12\x1e.follow.WatchAllFollowsRequest\x1a\x13.follow.FollowEvent0\x01B\x1dZ\x1bilianbuh.follow.v1;followv1b\x06proto3"
+
+var (
+	file_follow_proto_rawDescOnce sync.Once
+	file_follow_proto_rawDescData []byte
+)
+
+func file_follow_proto_rawDescGZIP() []byte {
+	file_follow_proto_rawDescOnce.Do(func() {
+		file_follow_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_follow_proto_rawDesc), len(file_follow_proto_rawDesc)))
+	})
+	return file_follow_proto_rawDescData
+}
+
+var file_follow_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_follow_proto_msgTypes = make([]protoimpl.MessageInfo, 62)
+var file_follow_proto_goTypes = []any{
+	(FollowState)(0),                     // 0: follow.FollowState
+	(Order)(0),                           // 1: follow.Order
+	(BulkStatus)(0),                      // 2: follow.BulkStatus
+	(FollowEventType)(0),                 // 3: follow.FollowEventType
+	(*FollowRequest)(nil),                // 4: follow.FollowRequest
+	(*FollowResponse)(nil),               // 5: follow.FollowResponse
+	(*UnfollowRequest)(nil),              // 6: follow.UnfollowRequest
+	(*UnfollowResponse)(nil),             // 7: follow.UnfollowResponse
+	(*ListFollowersRequest)(nil),         // 8: follow.ListFollowersRequest
+	(*ListFollowersResponse)(nil),        // 9: follow.ListFollowersResponse
+	(*ListFolloweesRequest)(nil),         // 10: follow.ListFolloweesRequest
+	(*ListFolloweesResponse)(nil),        // 11: follow.ListFolloweesResponse
+	(*Following)(nil),                    // 12: follow.Following
+	(*GetCommonFollowersRequest)(nil),    // 13: follow.GetCommonFollowersRequest
+	(*GetCommonFollowersResponse)(nil),   // 14: follow.GetCommonFollowersResponse
+	(*StreamFollowersRequest)(nil),       // 15: follow.StreamFollowersRequest
+	(*StreamFollowersResponse)(nil),      // 16: follow.StreamFollowersResponse
+	(*StreamFolloweesRequest)(nil),       // 17: follow.StreamFolloweesRequest
+	(*StreamFolloweesResponse)(nil),      // 18: follow.StreamFolloweesResponse
+	(*CountFollowersRequest)(nil),        // 19: follow.CountFollowersRequest
+	(*CountFollowersResponse)(nil),       // 20: follow.CountFollowersResponse
+	(*CountFolloweesRequest)(nil),        // 21: follow.CountFolloweesRequest
+	(*CountFolloweesResponse)(nil),       // 22: follow.CountFolloweesResponse
+	(*GetRelationshipsRequest)(nil),      // 23: follow.GetRelationshipsRequest
+	(*GetRelationshipsResponse)(nil),     // 24: follow.GetRelationshipsResponse
+	(*Relationship)(nil),                 // 25: follow.Relationship
+	(*BlockRequest)(nil),                 // 26: follow.BlockRequest
+	(*BlockResponse)(nil),                // 27: follow.BlockResponse
+	(*UnblockRequest)(nil),               // 28: follow.UnblockRequest
+	(*UnblockResponse)(nil),              // 29: follow.UnblockResponse
+	(*ListBlockedRequest)(nil),           // 30: follow.ListBlockedRequest
+	(*ListBlockedResponse)(nil),          // 31: follow.ListBlockedResponse
+	(*SetPrivacyRequest)(nil),            // 32: follow.SetPrivacyRequest
+	(*SetPrivacyResponse)(nil),           // 33: follow.SetPrivacyResponse
+	(*GetPrivacyRequest)(nil),            // 34: follow.GetPrivacyRequest
+	(*GetPrivacyResponse)(nil),           // 35: follow.GetPrivacyResponse
+	(*ListFollowRequestsRequest)(nil),    // 36: follow.ListFollowRequestsRequest
+	(*ListFollowRequestsResponse)(nil),   // 37: follow.ListFollowRequestsResponse
+	(*PendingFollow)(nil),                // 38: follow.PendingFollow
+	(*ApproveFollowRequestRequest)(nil),  // 39: follow.ApproveFollowRequestRequest
+	(*ApproveFollowRequestResponse)(nil), // 40: follow.ApproveFollowRequestResponse
+	(*RejectFollowRequestRequest)(nil),   // 41: follow.RejectFollowRequestRequest
+	(*RejectFollowRequestResponse)(nil),  // 42: follow.RejectFollowRequestResponse
+	(*MuteRequest)(nil),                  // 43: follow.MuteRequest
+	(*MuteResponse)(nil),                 // 44: follow.MuteResponse
+	(*UnmuteRequest)(nil),                // 45: follow.UnmuteRequest
+	(*UnmuteResponse)(nil),               // 46: follow.UnmuteResponse
+	(*ListMutualsRequest)(nil),           // 47: follow.ListMutualsRequest
+	(*ListMutualsResponse)(nil),          // 48: follow.ListMutualsResponse
+	(*CountMutualsRequest)(nil),          // 49: follow.CountMutualsRequest
+	(*CountMutualsResponse)(nil),         // 50: follow.CountMutualsResponse
+	(*SuggestFollowsRequest)(nil),        // 51: follow.SuggestFollowsRequest
+	(*SuggestFollowsResponse)(nil),       // 52: follow.SuggestFollowsResponse
+	(*Suggestion)(nil),                   // 53: follow.Suggestion
+	(*FollowPathRequest)(nil),            // 54: follow.FollowPathRequest
+	(*FollowPathResponse)(nil),           // 55: follow.FollowPathResponse
+	(*BulkFollowRequest)(nil),            // 56: follow.BulkFollowRequest
+	(*BulkFollowResponse)(nil),           // 57: follow.BulkFollowResponse
+	(*BulkUnfollowRequest)(nil),          // 58: follow.BulkUnfollowRequest
+	(*BulkUnfollowResponse)(nil),         // 59: follow.BulkUnfollowResponse
+	(*BulkResult)(nil),                   // 60: follow.BulkResult
+	(*WatchFollowsRequest)(nil),          // 61: follow.WatchFollowsRequest
+	(*FollowEvent)(nil),                  // 62: follow.FollowEvent
+	(*DeleteUserGraphRequest)(nil),       // 63: follow.DeleteUserGraphRequest
+	(*DeleteUserGraphResponse)(nil),      // 64: follow.DeleteUserGraphResponse
+	(*WatchAllFollowsRequest)(nil),       // 65: follow.WatchAllFollowsRequest
+	(*timestamppb.Timestamp)(nil),        // 66: google.protobuf.Timestamp
+}
+var file_follow_proto_depIdxs = []int32{
+	0,  // 0: follow.FollowResponse.state:type_name -> follow.FollowState
+	1,  // 1: follow.ListFollowersRequest.order:type_name -> follow.Order
+	12, // 2: follow.ListFollowersResponse.followings:type_name -> follow.Following
+	1,  // 3: follow.ListFolloweesRequest.order:type_name -> follow.Order
+	12, // 4: follow.ListFolloweesResponse.followings:type_name -> follow.Following
+	66, // 5: follow.Following.followed_at:type_name -> google.protobuf.Timestamp
+	25, // 6: follow.GetRelationshipsResponse.relationships:type_name -> follow.Relationship
+	38, // 7: follow.ListFollowRequestsResponse.requests:type_name -> follow.PendingFollow
+	66, // 8: follow.PendingFollow.requested_at:type_name -> google.protobuf.Timestamp
+	12, // 9: follow.ListMutualsResponse.followings:type_name -> follow.Following
+	53, // 10: follow.SuggestFollowsResponse.suggestions:type_name -> follow.Suggestion
+	60, // 11: follow.BulkFollowResponse.results:type_name -> follow.BulkResult
+	60, // 12: follow.BulkUnfollowResponse.results:type_name -> follow.BulkResult
+	2,  // 13: follow.BulkResult.status:type_name -> follow.BulkStatus
+	3,  // 14: follow.FollowEvent.type:type_name -> follow.FollowEventType
+	66, // 15: follow.FollowEvent.created_at:type_name -> google.protobuf.Timestamp
+	4,  // 16: follow.Follow.Follow:input_type -> follow.FollowRequest
+	6,  // 17: follow.Follow.Unfollow:input_type -> follow.UnfollowRequest
+	8,  // 18: follow.Follow.ListFollowers:input_type -> follow.ListFollowersRequest
+	10, // 19: follow.Follow.ListFollowees:input_type -> follow.ListFolloweesRequest
+	13, // 20: follow.Follow.GetCommonFollowers:input_type -> follow.GetCommonFollowersRequest
+	15, // 21: follow.Follow.StreamFollowers:input_type -> follow.StreamFollowersRequest
+	17, // 22: follow.Follow.StreamFollowees:input_type -> follow.StreamFolloweesRequest
+	19, // 23: follow.Follow.CountFollowers:input_type -> follow.CountFollowersRequest
+	21, // 24: follow.Follow.CountFollowees:input_type -> follow.CountFolloweesRequest
+	23, // 25: follow.Follow.GetRelationships:input_type -> follow.GetRelationshipsRequest
+	26, // 26: follow.Follow.Block:input_type -> follow.BlockRequest
+	28, // 27: follow.Follow.Unblock:input_type -> follow.UnblockRequest
+	30, // 28: follow.Follow.ListBlocked:input_type -> follow.ListBlockedRequest
+	32, // 29: follow.Follow.SetPrivacy:input_type -> follow.SetPrivacyRequest
+	34, // 30: follow.Follow.GetPrivacy:input_type -> follow.GetPrivacyRequest
+	36, // 31: follow.Follow.ListFollowRequests:input_type -> follow.ListFollowRequestsRequest
+	39, // 32: follow.Follow.ApproveFollowRequest:input_type -> follow.ApproveFollowRequestRequest
+	41, // 33: follow.Follow.RejectFollowRequest:input_type -> follow.RejectFollowRequestRequest
+	43, // 34: follow.Follow.Mute:input_type -> follow.MuteRequest
+	45, // 35: follow.Follow.Unmute:input_type -> follow.UnmuteRequest
+	47, // 36: follow.Follow.ListMutuals:input_type -> follow.ListMutualsRequest
+	49, // 37: follow.Follow.CountMutuals:input_type -> follow.CountMutualsRequest
+	51, // 38: follow.Follow.SuggestFollows:input_type -> follow.SuggestFollowsRequest
+	54, // 39: follow.Follow.FollowPath:input_type -> follow.FollowPathRequest
+	56, // 40: follow.Follow.BulkFollow:input_type -> follow.BulkFollowRequest
+	58, // 41: follow.Follow.BulkUnfollow:input_type -> follow.BulkUnfollowRequest
+	61, // 42: follow.Follow.WatchFollows:input_type -> follow.WatchFollowsRequest
+	63, // 43: follow.FollowAdmin.DeleteUserGraph:input_type -> follow.DeleteUserGraphRequest
+	65, // 44: follow.FollowAdmin.WatchAllFollows:input_type -> follow.WatchAllFollowsRequest
+	5,  // 45: follow.Follow.Follow:output_type -> follow.FollowResponse
+	7,  // 46: follow.Follow.Unfollow:output_type -> follow.UnfollowResponse
+	9,  // 47: follow.Follow.ListFollowers:output_type -> follow.ListFollowersResponse
+	11, // 48: follow.Follow.ListFollowees:output_type -> follow.ListFolloweesResponse
+	14, // 49: follow.Follow.GetCommonFollowers:output_type -> follow.GetCommonFollowersResponse
+	16, // 50: follow.Follow.StreamFollowers:output_type -> follow.StreamFollowersResponse
+	18, // 51: follow.Follow.StreamFollowees:output_type -> follow.StreamFolloweesResponse
+	20, // 52: follow.Follow.CountFollowers:output_type -> follow.CountFollowersResponse
+	22, // 53: follow.Follow.CountFollowees:output_type -> follow.CountFolloweesResponse
+	24, // 54: follow.Follow.GetRelationships:output_type -> follow.GetRelationshipsResponse
+	27, // 55: follow.Follow.Block:output_type -> follow.BlockResponse
+	29, // 56: follow.Follow.Unblock:output_type -> follow.UnblockResponse
+	31, // 57: follow.Follow.ListBlocked:output_type -> follow.ListBlockedResponse
+	33, // 58: follow.Follow.SetPrivacy:output_type -> follow.SetPrivacyResponse
+	35, // 59: follow.Follow.GetPrivacy:output_type -> follow.GetPrivacyResponse
+	37, // 60: follow.Follow.ListFollowRequests:output_type -> follow.ListFollowRequestsResponse
+	40, // 61: follow.Follow.ApproveFollowRequest:output_type -> follow.ApproveFollowRequestResponse
+	42, // 62: follow.Follow.RejectFollowRequest:output_type -> follow.RejectFollowRequestResponse
+	44, // 63: follow.Follow.Mute:output_type -> follow.MuteResponse
+	46, // 64: follow.Follow.Unmute:output_type -> follow.UnmuteResponse
+	48, // 65: follow.Follow.ListMutuals:output_type -> follow.ListMutualsResponse
+	50, // 66: follow.Follow.CountMutuals:output_type -> follow.CountMutualsResponse
+	52, // 67: follow.Follow.SuggestFollows:output_type -> follow.SuggestFollowsResponse
+	55, // 68: follow.Follow.FollowPath:output_type -> follow.FollowPathResponse
+	57, // 69: follow.Follow.BulkFollow:output_type -> follow.BulkFollowResponse
+	59, // 70: follow.Follow.BulkUnfollow:output_type -> follow.BulkUnfollowResponse
+	62, // 71: follow.Follow.WatchFollows:output_type -> follow.FollowEvent
+	64, // 72: follow.FollowAdmin.DeleteUserGraph:output_type -> follow.DeleteUserGraphResponse
+	62, // 73: follow.FollowAdmin.WatchAllFollows:output_type -> follow.FollowEvent
+	45, // [45:74] is the sub-list for method output_type
+	16, // [16:45] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
+}
+
+func init() { file_follow_proto_init() }
+func file_follow_proto_init() {
+	if File_follow_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_follow_proto_rawDesc), len(file_follow_proto_rawDesc)),
+			NumEnums:      4,
+			NumMessages:   62,
+			NumExtensions: 0,
+			NumServices:   2,
+		},
+		GoTypes:           file_follow_proto_goTypes,
+		DependencyIndexes: file_follow_proto_depIdxs,
+		EnumInfos:         file_follow_proto_enumTypes,
+		MessageInfos:      file_follow_proto_msgTypes,
+	}.Build()
+	File_follow_proto = out.File
+	file_follow_proto_goTypes = nil
+	file_follow_proto_depIdxs = nil
+}
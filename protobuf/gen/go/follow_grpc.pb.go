@@ -0,0 +1,1263 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: follow.proto
+
+package followv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Follow_Follow_FullMethodName               = "/follow.Follow/Follow"
+	Follow_Unfollow_FullMethodName             = "/follow.Follow/Unfollow"
+	Follow_ListFollowers_FullMethodName        = "/follow.Follow/ListFollowers"
+	Follow_ListFollowees_FullMethodName        = "/follow.Follow/ListFollowees"
+	Follow_GetCommonFollowers_FullMethodName   = "/follow.Follow/GetCommonFollowers"
+	Follow_StreamFollowers_FullMethodName      = "/follow.Follow/StreamFollowers"
+	Follow_StreamFollowees_FullMethodName      = "/follow.Follow/StreamFollowees"
+	Follow_CountFollowers_FullMethodName       = "/follow.Follow/CountFollowers"
+	Follow_CountFollowees_FullMethodName       = "/follow.Follow/CountFollowees"
+	Follow_GetRelationships_FullMethodName     = "/follow.Follow/GetRelationships"
+	Follow_Block_FullMethodName                = "/follow.Follow/Block"
+	Follow_Unblock_FullMethodName              = "/follow.Follow/Unblock"
+	Follow_ListBlocked_FullMethodName          = "/follow.Follow/ListBlocked"
+	Follow_SetPrivacy_FullMethodName           = "/follow.Follow/SetPrivacy"
+	Follow_GetPrivacy_FullMethodName           = "/follow.Follow/GetPrivacy"
+	Follow_ListFollowRequests_FullMethodName   = "/follow.Follow/ListFollowRequests"
+	Follow_ApproveFollowRequest_FullMethodName = "/follow.Follow/ApproveFollowRequest"
+	Follow_RejectFollowRequest_FullMethodName  = "/follow.Follow/RejectFollowRequest"
+	Follow_Mute_FullMethodName                 = "/follow.Follow/Mute"
+	Follow_Unmute_FullMethodName               = "/follow.Follow/Unmute"
+	Follow_ListMutuals_FullMethodName          = "/follow.Follow/ListMutuals"
+	Follow_CountMutuals_FullMethodName         = "/follow.Follow/CountMutuals"
+	Follow_SuggestFollows_FullMethodName       = "/follow.Follow/SuggestFollows"
+	Follow_FollowPath_FullMethodName           = "/follow.Follow/FollowPath"
+	Follow_BulkFollow_FullMethodName           = "/follow.Follow/BulkFollow"
+	Follow_BulkUnfollow_FullMethodName         = "/follow.Follow/BulkUnfollow"
+	Follow_WatchFollows_FullMethodName         = "/follow.Follow/WatchFollows"
+)
+
+// FollowClient is the client API for Follow service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FollowClient interface {
+	Follow(ctx context.Context, in *FollowRequest, opts ...grpc.CallOption) (*FollowResponse, error)
+	Unfollow(ctx context.Context, in *UnfollowRequest, opts ...grpc.CallOption) (*UnfollowResponse, error)
+	ListFollowers(ctx context.Context, in *ListFollowersRequest, opts ...grpc.CallOption) (*ListFollowersResponse, error)
+	ListFollowees(ctx context.Context, in *ListFolloweesRequest, opts ...grpc.CallOption) (*ListFolloweesResponse, error)
+	GetCommonFollowers(ctx context.Context, in *GetCommonFollowersRequest, opts ...grpc.CallOption) (*GetCommonFollowersResponse, error)
+	StreamFollowers(ctx context.Context, in *StreamFollowersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamFollowersResponse], error)
+	StreamFollowees(ctx context.Context, in *StreamFolloweesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamFolloweesResponse], error)
+	CountFollowers(ctx context.Context, in *CountFollowersRequest, opts ...grpc.CallOption) (*CountFollowersResponse, error)
+	CountFollowees(ctx context.Context, in *CountFolloweesRequest, opts ...grpc.CallOption) (*CountFolloweesResponse, error)
+	GetRelationships(ctx context.Context, in *GetRelationshipsRequest, opts ...grpc.CallOption) (*GetRelationshipsResponse, error)
+	Block(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockResponse, error)
+	Unblock(ctx context.Context, in *UnblockRequest, opts ...grpc.CallOption) (*UnblockResponse, error)
+	ListBlocked(ctx context.Context, in *ListBlockedRequest, opts ...grpc.CallOption) (*ListBlockedResponse, error)
+	SetPrivacy(ctx context.Context, in *SetPrivacyRequest, opts ...grpc.CallOption) (*SetPrivacyResponse, error)
+	GetPrivacy(ctx context.Context, in *GetPrivacyRequest, opts ...grpc.CallOption) (*GetPrivacyResponse, error)
+	ListFollowRequests(ctx context.Context, in *ListFollowRequestsRequest, opts ...grpc.CallOption) (*ListFollowRequestsResponse, error)
+	ApproveFollowRequest(ctx context.Context, in *ApproveFollowRequestRequest, opts ...grpc.CallOption) (*ApproveFollowRequestResponse, error)
+	RejectFollowRequest(ctx context.Context, in *RejectFollowRequestRequest, opts ...grpc.CallOption) (*RejectFollowRequestResponse, error)
+	Mute(ctx context.Context, in *MuteRequest, opts ...grpc.CallOption) (*MuteResponse, error)
+	Unmute(ctx context.Context, in *UnmuteRequest, opts ...grpc.CallOption) (*UnmuteResponse, error)
+	ListMutuals(ctx context.Context, in *ListMutualsRequest, opts ...grpc.CallOption) (*ListMutualsResponse, error)
+	CountMutuals(ctx context.Context, in *CountMutualsRequest, opts ...grpc.CallOption) (*CountMutualsResponse, error)
+	SuggestFollows(ctx context.Context, in *SuggestFollowsRequest, opts ...grpc.CallOption) (*SuggestFollowsResponse, error)
+	FollowPath(ctx context.Context, in *FollowPathRequest, opts ...grpc.CallOption) (*FollowPathResponse, error)
+	BulkFollow(ctx context.Context, in *BulkFollowRequest, opts ...grpc.CallOption) (*BulkFollowResponse, error)
+	BulkUnfollow(ctx context.Context, in *BulkUnfollowRequest, opts ...grpc.CallOption) (*BulkUnfollowResponse, error)
+	WatchFollows(ctx context.Context, in *WatchFollowsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[FollowEvent], error)
+}
+
+type followClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFollowClient(cc grpc.ClientConnInterface) FollowClient {
+	return &followClient{cc}
+}
+
+func (c *followClient) Follow(ctx context.Context, in *FollowRequest, opts ...grpc.CallOption) (*FollowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FollowResponse)
+	err := c.cc.Invoke(ctx, Follow_Follow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followClient) Unfollow(ctx context.Context, in *UnfollowRequest, opts ...grpc.CallOption) (*UnfollowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnfollowResponse)
+	err := c.cc.Invoke(ctx, Follow_Unfollow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followClient) ListFollowers(ctx context.Context, in *ListFollowersRequest, opts ...grpc.CallOption) (*ListFollowersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFollowersResponse)
+	err := c.cc.Invoke(ctx, Follow_ListFollowers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followClient) ListFollowees(ctx context.Context, in *ListFolloweesRequest, opts ...grpc.CallOption) (*ListFolloweesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFolloweesResponse)
+	err := c.cc.Invoke(ctx, Follow_ListFollowees_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followClient) GetCommonFollowers(ctx context.Context, in *GetCommonFollowersRequest, opts ...grpc.CallOption) (*GetCommonFollowersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCommonFollowersResponse)
+	err := c.cc.Invoke(ctx, Follow_GetCommonFollowers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followClient) StreamFollowers(ctx context.Context, in *StreamFollowersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamFollowersResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Follow_ServiceDesc.Streams[0], Follow_StreamFollowers_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamFollowersRequest, StreamFollowersResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Follow_StreamFollowersClient = grpc.ServerStreamingClient[StreamFollowersResponse]
+
+func (c *followClient) StreamFollowees(ctx context.Context, in *StreamFolloweesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamFolloweesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Follow_ServiceDesc.Streams[1], Follow_StreamFollowees_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamFolloweesRequest, StreamFolloweesResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Follow_StreamFolloweesClient = grpc.ServerStreamingClient[StreamFolloweesResponse]
+
+func (c *followClient) CountFollowers(ctx context.Context, in *CountFollowersRequest, opts ...grpc.CallOption) (*CountFollowersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CountFollowersResponse)
+	err := c.cc.Invoke(ctx, Follow_CountFollowers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followClient) CountFollowees(ctx context.Context, in *CountFolloweesRequest, opts ...grpc.CallOption) (*CountFolloweesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CountFolloweesResponse)
+	err := c.cc.Invoke(ctx, Follow_CountFollowees_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followClient) GetRelationships(ctx context.Context, in *GetRelationshipsRequest, opts ...grpc.CallOption) (*GetRelationshipsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRelationshipsResponse)
+	err := c.cc.Invoke(ctx, Follow_GetRelationships_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followClient) Block(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*BlockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockResponse)
+	err := c.cc.Invoke(ctx, Follow_Block_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followClient) Unblock(ctx context.Context, in *UnblockRequest, opts ...grpc.CallOption) (*UnblockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnblockResponse)
+	err := c.cc.Invoke(ctx, Follow_Unblock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followClient) ListBlocked(ctx context.Context, in *ListBlockedRequest, opts ...grpc.CallOption) (*ListBlockedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBlockedResponse)
+	err := c.cc.Invoke(ctx, Follow_ListBlocked_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followClient) SetPrivacy(ctx context.Context, in *SetPrivacyRequest, opts ...grpc.CallOption) (*SetPrivacyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetPrivacyResponse)
+	err := c.cc.Invoke(ctx, Follow_SetPrivacy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followClient) GetPrivacy(ctx context.Context, in *GetPrivacyRequest, opts ...grpc.CallOption) (*GetPrivacyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPrivacyResponse)
+	err := c.cc.Invoke(ctx, Follow_GetPrivacy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followClient) ListFollowRequests(ctx context.Context, in *ListFollowRequestsRequest, opts ...grpc.CallOption) (*ListFollowRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFollowRequestsResponse)
+	err := c.cc.Invoke(ctx, Follow_ListFollowRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followClient) ApproveFollowRequest(ctx context.Context, in *ApproveFollowRequestRequest, opts ...grpc.CallOption) (*ApproveFollowRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveFollowRequestResponse)
+	err := c.cc.Invoke(ctx, Follow_ApproveFollowRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followClient) RejectFollowRequest(ctx context.Context, in *RejectFollowRequestRequest, opts ...grpc.CallOption) (*RejectFollowRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectFollowRequestResponse)
+	err := c.cc.Invoke(ctx, Follow_RejectFollowRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followClient) Mute(ctx context.Context, in *MuteRequest, opts ...grpc.CallOption) (*MuteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MuteResponse)
+	err := c.cc.Invoke(ctx, Follow_Mute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followClient) Unmute(ctx context.Context, in *UnmuteRequest, opts ...grpc.CallOption) (*UnmuteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnmuteResponse)
+	err := c.cc.Invoke(ctx, Follow_Unmute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followClient) ListMutuals(ctx context.Context, in *ListMutualsRequest, opts ...grpc.CallOption) (*ListMutualsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMutualsResponse)
+	err := c.cc.Invoke(ctx, Follow_ListMutuals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followClient) CountMutuals(ctx context.Context, in *CountMutualsRequest, opts ...grpc.CallOption) (*CountMutualsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CountMutualsResponse)
+	err := c.cc.Invoke(ctx, Follow_CountMutuals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followClient) SuggestFollows(ctx context.Context, in *SuggestFollowsRequest, opts ...grpc.CallOption) (*SuggestFollowsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestFollowsResponse)
+	err := c.cc.Invoke(ctx, Follow_SuggestFollows_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followClient) FollowPath(ctx context.Context, in *FollowPathRequest, opts ...grpc.CallOption) (*FollowPathResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FollowPathResponse)
+	err := c.cc.Invoke(ctx, Follow_FollowPath_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followClient) BulkFollow(ctx context.Context, in *BulkFollowRequest, opts ...grpc.CallOption) (*BulkFollowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkFollowResponse)
+	err := c.cc.Invoke(ctx, Follow_BulkFollow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followClient) BulkUnfollow(ctx context.Context, in *BulkUnfollowRequest, opts ...grpc.CallOption) (*BulkUnfollowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkUnfollowResponse)
+	err := c.cc.Invoke(ctx, Follow_BulkUnfollow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followClient) WatchFollows(ctx context.Context, in *WatchFollowsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[FollowEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Follow_ServiceDesc.Streams[2], Follow_WatchFollows_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchFollowsRequest, FollowEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Follow_WatchFollowsClient = grpc.ServerStreamingClient[FollowEvent]
+
+// FollowServer is the server API for Follow service.
+// All implementations must embed UnimplementedFollowServer
+// for forward compatibility.
+type FollowServer interface {
+	Follow(context.Context, *FollowRequest) (*FollowResponse, error)
+	Unfollow(context.Context, *UnfollowRequest) (*UnfollowResponse, error)
+	ListFollowers(context.Context, *ListFollowersRequest) (*ListFollowersResponse, error)
+	ListFollowees(context.Context, *ListFolloweesRequest) (*ListFolloweesResponse, error)
+	GetCommonFollowers(context.Context, *GetCommonFollowersRequest) (*GetCommonFollowersResponse, error)
+	StreamFollowers(*StreamFollowersRequest, grpc.ServerStreamingServer[StreamFollowersResponse]) error
+	StreamFollowees(*StreamFolloweesRequest, grpc.ServerStreamingServer[StreamFolloweesResponse]) error
+	CountFollowers(context.Context, *CountFollowersRequest) (*CountFollowersResponse, error)
+	CountFollowees(context.Context, *CountFolloweesRequest) (*CountFolloweesResponse, error)
+	GetRelationships(context.Context, *GetRelationshipsRequest) (*GetRelationshipsResponse, error)
+	Block(context.Context, *BlockRequest) (*BlockResponse, error)
+	Unblock(context.Context, *UnblockRequest) (*UnblockResponse, error)
+	ListBlocked(context.Context, *ListBlockedRequest) (*ListBlockedResponse, error)
+	SetPrivacy(context.Context, *SetPrivacyRequest) (*SetPrivacyResponse, error)
+	GetPrivacy(context.Context, *GetPrivacyRequest) (*GetPrivacyResponse, error)
+	ListFollowRequests(context.Context, *ListFollowRequestsRequest) (*ListFollowRequestsResponse, error)
+	ApproveFollowRequest(context.Context, *ApproveFollowRequestRequest) (*ApproveFollowRequestResponse, error)
+	RejectFollowRequest(context.Context, *RejectFollowRequestRequest) (*RejectFollowRequestResponse, error)
+	Mute(context.Context, *MuteRequest) (*MuteResponse, error)
+	Unmute(context.Context, *UnmuteRequest) (*UnmuteResponse, error)
+	ListMutuals(context.Context, *ListMutualsRequest) (*ListMutualsResponse, error)
+	CountMutuals(context.Context, *CountMutualsRequest) (*CountMutualsResponse, error)
+	SuggestFollows(context.Context, *SuggestFollowsRequest) (*SuggestFollowsResponse, error)
+	FollowPath(context.Context, *FollowPathRequest) (*FollowPathResponse, error)
+	BulkFollow(context.Context, *BulkFollowRequest) (*BulkFollowResponse, error)
+	BulkUnfollow(context.Context, *BulkUnfollowRequest) (*BulkUnfollowResponse, error)
+	WatchFollows(*WatchFollowsRequest, grpc.ServerStreamingServer[FollowEvent]) error
+	mustEmbedUnimplementedFollowServer()
+}
+
+// UnimplementedFollowServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedFollowServer struct{}
+
+func (UnimplementedFollowServer) Follow(context.Context, *FollowRequest) (*FollowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Follow not implemented")
+}
+func (UnimplementedFollowServer) Unfollow(context.Context, *UnfollowRequest) (*UnfollowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unfollow not implemented")
+}
+func (UnimplementedFollowServer) ListFollowers(context.Context, *ListFollowersRequest) (*ListFollowersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFollowers not implemented")
+}
+func (UnimplementedFollowServer) ListFollowees(context.Context, *ListFolloweesRequest) (*ListFolloweesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFollowees not implemented")
+}
+func (UnimplementedFollowServer) GetCommonFollowers(context.Context, *GetCommonFollowersRequest) (*GetCommonFollowersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommonFollowers not implemented")
+}
+func (UnimplementedFollowServer) StreamFollowers(*StreamFollowersRequest, grpc.ServerStreamingServer[StreamFollowersResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamFollowers not implemented")
+}
+func (UnimplementedFollowServer) StreamFollowees(*StreamFolloweesRequest, grpc.ServerStreamingServer[StreamFolloweesResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamFollowees not implemented")
+}
+func (UnimplementedFollowServer) CountFollowers(context.Context, *CountFollowersRequest) (*CountFollowersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountFollowers not implemented")
+}
+func (UnimplementedFollowServer) CountFollowees(context.Context, *CountFolloweesRequest) (*CountFolloweesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountFollowees not implemented")
+}
+func (UnimplementedFollowServer) GetRelationships(context.Context, *GetRelationshipsRequest) (*GetRelationshipsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRelationships not implemented")
+}
+func (UnimplementedFollowServer) Block(context.Context, *BlockRequest) (*BlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Block not implemented")
+}
+func (UnimplementedFollowServer) Unblock(context.Context, *UnblockRequest) (*UnblockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unblock not implemented")
+}
+func (UnimplementedFollowServer) ListBlocked(context.Context, *ListBlockedRequest) (*ListBlockedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBlocked not implemented")
+}
+func (UnimplementedFollowServer) SetPrivacy(context.Context, *SetPrivacyRequest) (*SetPrivacyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPrivacy not implemented")
+}
+func (UnimplementedFollowServer) GetPrivacy(context.Context, *GetPrivacyRequest) (*GetPrivacyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPrivacy not implemented")
+}
+func (UnimplementedFollowServer) ListFollowRequests(context.Context, *ListFollowRequestsRequest) (*ListFollowRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFollowRequests not implemented")
+}
+func (UnimplementedFollowServer) ApproveFollowRequest(context.Context, *ApproveFollowRequestRequest) (*ApproveFollowRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveFollowRequest not implemented")
+}
+func (UnimplementedFollowServer) RejectFollowRequest(context.Context, *RejectFollowRequestRequest) (*RejectFollowRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectFollowRequest not implemented")
+}
+func (UnimplementedFollowServer) Mute(context.Context, *MuteRequest) (*MuteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Mute not implemented")
+}
+func (UnimplementedFollowServer) Unmute(context.Context, *UnmuteRequest) (*UnmuteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unmute not implemented")
+}
+func (UnimplementedFollowServer) ListMutuals(context.Context, *ListMutualsRequest) (*ListMutualsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMutuals not implemented")
+}
+func (UnimplementedFollowServer) CountMutuals(context.Context, *CountMutualsRequest) (*CountMutualsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountMutuals not implemented")
+}
+func (UnimplementedFollowServer) SuggestFollows(context.Context, *SuggestFollowsRequest) (*SuggestFollowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestFollows not implemented")
+}
+func (UnimplementedFollowServer) FollowPath(context.Context, *FollowPathRequest) (*FollowPathResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FollowPath not implemented")
+}
+func (UnimplementedFollowServer) BulkFollow(context.Context, *BulkFollowRequest) (*BulkFollowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkFollow not implemented")
+}
+func (UnimplementedFollowServer) BulkUnfollow(context.Context, *BulkUnfollowRequest) (*BulkUnfollowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkUnfollow not implemented")
+}
+func (UnimplementedFollowServer) WatchFollows(*WatchFollowsRequest, grpc.ServerStreamingServer[FollowEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchFollows not implemented")
+}
+func (UnimplementedFollowServer) mustEmbedUnimplementedFollowServer() {}
+func (UnimplementedFollowServer) testEmbeddedByValue()                {}
+
+// UnsafeFollowServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FollowServer will
+// result in compilation errors.
+type UnsafeFollowServer interface {
+	mustEmbedUnimplementedFollowServer()
+}
+
+func RegisterFollowServer(s grpc.ServiceRegistrar, srv FollowServer) {
+	// If the following call pancis, it indicates UnimplementedFollowServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Follow_ServiceDesc, srv)
+}
+
+func _Follow_Follow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServer).Follow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Follow_Follow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServer).Follow(ctx, req.(*FollowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Follow_Unfollow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfollowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServer).Unfollow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Follow_Unfollow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServer).Unfollow(ctx, req.(*UnfollowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Follow_ListFollowers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFollowersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServer).ListFollowers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Follow_ListFollowers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServer).ListFollowers(ctx, req.(*ListFollowersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Follow_ListFollowees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFolloweesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServer).ListFollowees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Follow_ListFollowees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServer).ListFollowees(ctx, req.(*ListFolloweesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Follow_GetCommonFollowers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommonFollowersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServer).GetCommonFollowers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Follow_GetCommonFollowers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServer).GetCommonFollowers(ctx, req.(*GetCommonFollowersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Follow_StreamFollowers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamFollowersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FollowServer).StreamFollowers(m, &grpc.GenericServerStream[StreamFollowersRequest, StreamFollowersResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Follow_StreamFollowersServer = grpc.ServerStreamingServer[StreamFollowersResponse]
+
+func _Follow_StreamFollowees_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamFolloweesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FollowServer).StreamFollowees(m, &grpc.GenericServerStream[StreamFolloweesRequest, StreamFolloweesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Follow_StreamFolloweesServer = grpc.ServerStreamingServer[StreamFolloweesResponse]
+
+func _Follow_CountFollowers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountFollowersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServer).CountFollowers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Follow_CountFollowers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServer).CountFollowers(ctx, req.(*CountFollowersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Follow_CountFollowees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountFolloweesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServer).CountFollowees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Follow_CountFollowees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServer).CountFollowees(ctx, req.(*CountFolloweesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Follow_GetRelationships_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRelationshipsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServer).GetRelationships(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Follow_GetRelationships_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServer).GetRelationships(ctx, req.(*GetRelationshipsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Follow_Block_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServer).Block(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Follow_Block_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServer).Block(ctx, req.(*BlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Follow_Unblock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServer).Unblock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Follow_Unblock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServer).Unblock(ctx, req.(*UnblockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Follow_ListBlocked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBlockedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServer).ListBlocked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Follow_ListBlocked_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServer).ListBlocked(ctx, req.(*ListBlockedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Follow_SetPrivacy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPrivacyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServer).SetPrivacy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Follow_SetPrivacy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServer).SetPrivacy(ctx, req.(*SetPrivacyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Follow_GetPrivacy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPrivacyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServer).GetPrivacy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Follow_GetPrivacy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServer).GetPrivacy(ctx, req.(*GetPrivacyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Follow_ListFollowRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFollowRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServer).ListFollowRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Follow_ListFollowRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServer).ListFollowRequests(ctx, req.(*ListFollowRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Follow_ApproveFollowRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveFollowRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServer).ApproveFollowRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Follow_ApproveFollowRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServer).ApproveFollowRequest(ctx, req.(*ApproveFollowRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Follow_RejectFollowRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectFollowRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServer).RejectFollowRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Follow_RejectFollowRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServer).RejectFollowRequest(ctx, req.(*RejectFollowRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Follow_Mute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServer).Mute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Follow_Mute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServer).Mute(ctx, req.(*MuteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Follow_Unmute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnmuteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServer).Unmute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Follow_Unmute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServer).Unmute(ctx, req.(*UnmuteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Follow_ListMutuals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMutualsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServer).ListMutuals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Follow_ListMutuals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServer).ListMutuals(ctx, req.(*ListMutualsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Follow_CountMutuals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountMutualsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServer).CountMutuals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Follow_CountMutuals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServer).CountMutuals(ctx, req.(*CountMutualsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Follow_SuggestFollows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestFollowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServer).SuggestFollows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Follow_SuggestFollows_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServer).SuggestFollows(ctx, req.(*SuggestFollowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Follow_FollowPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowPathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServer).FollowPath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Follow_FollowPath_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServer).FollowPath(ctx, req.(*FollowPathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Follow_BulkFollow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkFollowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServer).BulkFollow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Follow_BulkFollow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServer).BulkFollow(ctx, req.(*BulkFollowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Follow_BulkUnfollow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkUnfollowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowServer).BulkUnfollow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Follow_BulkUnfollow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowServer).BulkUnfollow(ctx, req.(*BulkUnfollowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Follow_WatchFollows_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchFollowsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FollowServer).WatchFollows(m, &grpc.GenericServerStream[WatchFollowsRequest, FollowEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Follow_WatchFollowsServer = grpc.ServerStreamingServer[FollowEvent]
+
+// Follow_ServiceDesc is the grpc.ServiceDesc for Follow service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Follow_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "follow.Follow",
+	HandlerType: (*FollowServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Follow",
+			Handler:    _Follow_Follow_Handler,
+		},
+		{
+			MethodName: "Unfollow",
+			Handler:    _Follow_Unfollow_Handler,
+		},
+		{
+			MethodName: "ListFollowers",
+			Handler:    _Follow_ListFollowers_Handler,
+		},
+		{
+			MethodName: "ListFollowees",
+			Handler:    _Follow_ListFollowees_Handler,
+		},
+		{
+			MethodName: "GetCommonFollowers",
+			Handler:    _Follow_GetCommonFollowers_Handler,
+		},
+		{
+			MethodName: "CountFollowers",
+			Handler:    _Follow_CountFollowers_Handler,
+		},
+		{
+			MethodName: "CountFollowees",
+			Handler:    _Follow_CountFollowees_Handler,
+		},
+		{
+			MethodName: "GetRelationships",
+			Handler:    _Follow_GetRelationships_Handler,
+		},
+		{
+			MethodName: "Block",
+			Handler:    _Follow_Block_Handler,
+		},
+		{
+			MethodName: "Unblock",
+			Handler:    _Follow_Unblock_Handler,
+		},
+		{
+			MethodName: "ListBlocked",
+			Handler:    _Follow_ListBlocked_Handler,
+		},
+		{
+			MethodName: "SetPrivacy",
+			Handler:    _Follow_SetPrivacy_Handler,
+		},
+		{
+			MethodName: "GetPrivacy",
+			Handler:    _Follow_GetPrivacy_Handler,
+		},
+		{
+			MethodName: "ListFollowRequests",
+			Handler:    _Follow_ListFollowRequests_Handler,
+		},
+		{
+			MethodName: "ApproveFollowRequest",
+			Handler:    _Follow_ApproveFollowRequest_Handler,
+		},
+		{
+			MethodName: "RejectFollowRequest",
+			Handler:    _Follow_RejectFollowRequest_Handler,
+		},
+		{
+			MethodName: "Mute",
+			Handler:    _Follow_Mute_Handler,
+		},
+		{
+			MethodName: "Unmute",
+			Handler:    _Follow_Unmute_Handler,
+		},
+		{
+			MethodName: "ListMutuals",
+			Handler:    _Follow_ListMutuals_Handler,
+		},
+		{
+			MethodName: "CountMutuals",
+			Handler:    _Follow_CountMutuals_Handler,
+		},
+		{
+			MethodName: "SuggestFollows",
+			Handler:    _Follow_SuggestFollows_Handler,
+		},
+		{
+			MethodName: "FollowPath",
+			Handler:    _Follow_FollowPath_Handler,
+		},
+		{
+			MethodName: "BulkFollow",
+			Handler:    _Follow_BulkFollow_Handler,
+		},
+		{
+			MethodName: "BulkUnfollow",
+			Handler:    _Follow_BulkUnfollow_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamFollowers",
+			Handler:       _Follow_StreamFollowers_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamFollowees",
+			Handler:       _Follow_StreamFollowees_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchFollows",
+			Handler:       _Follow_WatchFollows_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "follow.proto",
+}
+
+const (
+	FollowAdmin_DeleteUserGraph_FullMethodName = "/follow.FollowAdmin/DeleteUserGraph"
+	FollowAdmin_WatchAllFollows_FullMethodName = "/follow.FollowAdmin/WatchAllFollows"
+)
+
+// FollowAdminClient is the client API for FollowAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FollowAdminClient interface {
+	DeleteUserGraph(ctx context.Context, in *DeleteUserGraphRequest, opts ...grpc.CallOption) (*DeleteUserGraphResponse, error)
+	WatchAllFollows(ctx context.Context, in *WatchAllFollowsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[FollowEvent], error)
+}
+
+type followAdminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFollowAdminClient(cc grpc.ClientConnInterface) FollowAdminClient {
+	return &followAdminClient{cc}
+}
+
+func (c *followAdminClient) DeleteUserGraph(ctx context.Context, in *DeleteUserGraphRequest, opts ...grpc.CallOption) (*DeleteUserGraphResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteUserGraphResponse)
+	err := c.cc.Invoke(ctx, FollowAdmin_DeleteUserGraph_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *followAdminClient) WatchAllFollows(ctx context.Context, in *WatchAllFollowsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[FollowEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &FollowAdmin_ServiceDesc.Streams[0], FollowAdmin_WatchAllFollows_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchAllFollowsRequest, FollowEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FollowAdmin_WatchAllFollowsClient = grpc.ServerStreamingClient[FollowEvent]
+
+// FollowAdminServer is the server API for FollowAdmin service.
+// All implementations must embed UnimplementedFollowAdminServer
+// for forward compatibility.
+type FollowAdminServer interface {
+	DeleteUserGraph(context.Context, *DeleteUserGraphRequest) (*DeleteUserGraphResponse, error)
+	WatchAllFollows(*WatchAllFollowsRequest, grpc.ServerStreamingServer[FollowEvent]) error
+	mustEmbedUnimplementedFollowAdminServer()
+}
+
+// UnimplementedFollowAdminServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedFollowAdminServer struct{}
+
+func (UnimplementedFollowAdminServer) DeleteUserGraph(context.Context, *DeleteUserGraphRequest) (*DeleteUserGraphResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserGraph not implemented")
+}
+func (UnimplementedFollowAdminServer) WatchAllFollows(*WatchAllFollowsRequest, grpc.ServerStreamingServer[FollowEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchAllFollows not implemented")
+}
+func (UnimplementedFollowAdminServer) mustEmbedUnimplementedFollowAdminServer() {}
+func (UnimplementedFollowAdminServer) testEmbeddedByValue()                     {}
+
+// UnsafeFollowAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FollowAdminServer will
+// result in compilation errors.
+type UnsafeFollowAdminServer interface {
+	mustEmbedUnimplementedFollowAdminServer()
+}
+
+func RegisterFollowAdminServer(s grpc.ServiceRegistrar, srv FollowAdminServer) {
+	// If the following call pancis, it indicates UnimplementedFollowAdminServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&FollowAdmin_ServiceDesc, srv)
+}
+
+func _FollowAdmin_DeleteUserGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FollowAdminServer).DeleteUserGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FollowAdmin_DeleteUserGraph_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FollowAdminServer).DeleteUserGraph(ctx, req.(*DeleteUserGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FollowAdmin_WatchAllFollows_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchAllFollowsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FollowAdminServer).WatchAllFollows(m, &grpc.GenericServerStream[WatchAllFollowsRequest, FollowEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FollowAdmin_WatchAllFollowsServer = grpc.ServerStreamingServer[FollowEvent]
+
+// FollowAdmin_ServiceDesc is the grpc.ServiceDesc for FollowAdmin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FollowAdmin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "follow.FollowAdmin",
+	HandlerType: (*FollowAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DeleteUserGraph",
+			Handler:    _FollowAdmin_DeleteUserGraph_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchAllFollows",
+			Handler:       _FollowAdmin_WatchAllFollows_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "follow.proto",
+}
@@ -0,0 +1,41 @@
+package tests
+
+import (
+	followv1 "github.com/IlianBuh/Follow_Service/protobuf/gen/go"
+	"github.com/IlianBuh/Follow_Service/tests/suite"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestBlockRemovesAndForbidsFollowing(t *testing.T) {
+	ctx, st := suite.New(t)
+
+	rand := newRand()
+
+	src, target := randUUID(rand), randUUID(rand)
+
+	_, err := st.Client.Follow(ctx, &followv1.FollowRequest{Src: target, Target: src})
+	require.NoError(t, err)
+
+	_, err = st.Client.Block(ctx, &followv1.BlockRequest{Src: src, Target: target})
+	require.NoError(t, err)
+
+	followers, err := st.Client.ListFollowers(ctx, &followv1.ListFollowersRequest{Uuid: src})
+	require.NoError(t, err)
+	require.NotContains(t, followers.GetUuids(), target)
+
+	_, err = st.Client.Follow(ctx, &followv1.FollowRequest{Src: target, Target: src})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	blocked, err := st.Client.ListBlocked(ctx, &followv1.ListBlockedRequest{Uuid: src})
+	require.NoError(t, err)
+	require.Equal(t, []int32{target}, blocked.GetUuids())
+
+	_, err = st.Client.Unblock(ctx, &followv1.UnblockRequest{Src: src, Target: target})
+	require.NoError(t, err)
+
+	_, err = st.Client.Follow(ctx, &followv1.FollowRequest{Src: target, Target: src})
+	require.NoError(t, err)
+}
@@ -0,0 +1,39 @@
+package tests
+
+import (
+	followv1 "github.com/IlianBuh/Follow_Service/protobuf/gen/go"
+	"github.com/IlianBuh/Follow_Service/tests/suite"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestBulkFollowUnfollow(t *testing.T) {
+	ctx, st := suite.New(t)
+
+	rand := newRand()
+
+	src := randUUID(rand)
+	targets := randomInt32Slice(5, rand)
+
+	_, err := st.Client.Follow(ctx, &followv1.FollowRequest{Src: src, Target: targets[0]})
+	require.NoError(t, err)
+
+	followed, err := st.Client.BulkFollow(ctx, &followv1.BulkFollowRequest{Src: src, Targets: targets})
+	require.NoError(t, err)
+	require.Len(t, followed.GetResults(), len(targets))
+	require.Equal(t, followv1.BulkStatus_BULK_STATUS_ALREADY_FOLLOWING, followed.GetResults()[0].GetStatus())
+	for i, v := range followed.GetResults()[1:] {
+		require.Equal(t, targets[i+1], v.GetTarget())
+		require.Equal(t, followv1.BulkStatus_BULK_STATUS_FOLLOWED, v.GetStatus())
+	}
+
+	unfollowed, err := st.Client.BulkUnfollow(ctx, &followv1.BulkUnfollowRequest{Src: src, Targets: targets})
+	require.NoError(t, err)
+	for _, v := range unfollowed.GetResults() {
+		require.Equal(t, followv1.BulkStatus_BULK_STATUS_UNFOLLOWED, v.GetStatus())
+	}
+
+	cnt, err := st.Client.CountFollowees(ctx, &followv1.CountFolloweesRequest{Uuid: src})
+	require.NoError(t, err)
+	require.Zero(t, cnt.GetCount())
+}
@@ -0,0 +1,40 @@
+package tests
+
+import (
+	followv1 "github.com/IlianBuh/Follow_Service/protobuf/gen/go"
+	"github.com/IlianBuh/Follow_Service/tests/suite"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestGetCommonFollowers(t *testing.T) {
+	ctx, st := suite.New(t)
+
+	rand := newRand()
+
+	viewer, target := randUUID(rand), randUUID(rand)
+	followees := randomInt32Slice(5, rand)
+	for _, v := range followees {
+		_, err := st.Client.Follow(ctx, &followv1.FollowRequest{Src: viewer, Target: v})
+		require.NoError(t, err)
+	}
+
+	common := followees[:3]
+	for _, v := range common {
+		_, err := st.Client.Follow(ctx, &followv1.FollowRequest{Src: v, Target: target})
+		require.NoError(t, err)
+	}
+
+	resp, err := st.Client.GetCommonFollowers(
+		ctx,
+		&followv1.GetCommonFollowersRequest{
+			Viewer:     viewer,
+			Target:     target,
+			SampleSize: 2,
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, int64(len(common)), resp.GetCount())
+	require.Len(t, resp.GetSample(), 2)
+	require.Subset(t, common, resp.GetSample())
+}
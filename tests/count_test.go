@@ -0,0 +1,44 @@
+package tests
+
+import (
+	followv1 "github.com/IlianBuh/Follow_Service/protobuf/gen/go"
+	"github.com/IlianBuh/Follow_Service/tests/suite"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestCountFollowersFollowees(t *testing.T) {
+	ctx, st := suite.New(t)
+
+	rand := newRand()
+
+	uuid := randUUID(rand)
+	followers := randomInt32Slice(5, rand)
+	for _, v := range followers {
+		_, err := st.Client.Follow(
+			ctx,
+			&followv1.FollowRequest{
+				Src:    v,
+				Target: uuid,
+			},
+		)
+		require.NoError(t, err)
+	}
+
+	_, err := st.Client.Unfollow(
+		ctx,
+		&followv1.UnfollowRequest{
+			Src:    followers[0],
+			Target: uuid,
+		},
+	)
+	require.NoError(t, err)
+
+	cntFollowers, err := st.Client.CountFollowers(ctx, &followv1.CountFollowersRequest{Uuid: uuid})
+	require.NoError(t, err)
+	require.Equal(t, int64(len(followers)-1), cntFollowers.GetCount())
+
+	cntFollowees, err := st.Client.CountFollowees(ctx, &followv1.CountFolloweesRequest{Uuid: followers[1]})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), cntFollowees.GetCount())
+}
@@ -0,0 +1,39 @@
+package tests
+
+import (
+	followv1 "github.com/IlianBuh/Follow_Service/protobuf/gen/go"
+	"github.com/IlianBuh/Follow_Service/tests/suite"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestDeleteUserGraph(t *testing.T) {
+	ctx, st := suite.New(t)
+
+	rand := newRand()
+
+	uuid := randUUID(rand)
+	followers := randomInt32Slice(3, rand)
+	followees := randomInt32Slice(2, rand)
+	for _, v := range followers {
+		_, err := st.Client.Follow(ctx, &followv1.FollowRequest{Src: v, Target: uuid})
+		require.NoError(t, err)
+	}
+	for _, v := range followees {
+		_, err := st.Client.Follow(ctx, &followv1.FollowRequest{Src: uuid, Target: v})
+		require.NoError(t, err)
+	}
+
+	deleted, err := st.AdminClient.DeleteUserGraph(ctx, &followv1.DeleteUserGraphRequest{Uuid: uuid})
+	require.NoError(t, err)
+	require.Equal(t, int64(len(followers)), deleted.GetFollowers())
+	require.Equal(t, int64(len(followees)), deleted.GetFollowees())
+
+	list, err := st.Client.ListFollowers(ctx, &followv1.ListFollowersRequest{Uuid: followees[0]})
+	require.NoError(t, err)
+	require.NotContains(t, list.GetUuids(), uuid)
+
+	cnt, err := st.Client.CountFollowees(ctx, &followv1.CountFolloweesRequest{Uuid: followers[0]})
+	require.NoError(t, err)
+	require.Zero(t, cnt.GetCount())
+}
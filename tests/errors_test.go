@@ -0,0 +1,108 @@
+package tests
+
+import (
+	"fmt"
+	followv1 "github.com/IlianBuh/Follow_Service/protobuf/gen/go"
+	"github.com/IlianBuh/Follow_Service/tests/suite"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+	"time"
+)
+
+func TestFollowTwiceIsAlreadyExists(t *testing.T) {
+	ctx, st := suite.New(t)
+
+	rand := newRand()
+
+	src, target := randUUID(rand), randUUID(rand)
+
+	_, err := st.Client.Follow(ctx, &followv1.FollowRequest{Src: src, Target: target})
+	require.NoError(t, err)
+
+	_, err = st.Client.Follow(ctx, &followv1.FollowRequest{Src: src, Target: target})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	info := errorInfo(t, err)
+	require.Equal(t, "ALREADY_FOLLOWING", info.GetReason())
+	require.Equal(t, fmt.Sprintf("%d,%d", src, target), info.GetMetadata()["uuids"])
+}
+
+func TestNegativeUUIDIsInvalidArgument(t *testing.T) {
+	ctx, st := suite.New(t)
+
+	_, err := st.Client.Follow(ctx, &followv1.FollowRequest{Src: -1, Target: 1})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.Equal(t, "INVALID_UUID", errorInfo(t, err).GetReason())
+}
+
+func TestSelfFollowIsInvalidArgument(t *testing.T) {
+	ctx, st := suite.New(t)
+
+	rand := newRand()
+
+	uuid := randUUID(rand)
+
+	_, err := st.Client.Follow(ctx, &followv1.FollowRequest{Src: uuid, Target: uuid})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.Equal(t, "SELF_TARGET", errorInfo(t, err).GetReason())
+
+	cnt, err := st.Client.CountFollowers(ctx, &followv1.CountFollowersRequest{Uuid: uuid})
+	require.NoError(t, err)
+	require.Zero(t, cnt.GetCount())
+}
+
+func TestZeroUUIDIsInvalidArgument(t *testing.T) {
+	ctx, st := suite.New(t)
+
+	_, err := st.Client.CountFollowers(ctx, &followv1.CountFollowersRequest{Uuid: 0})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.Equal(t, "INVALID_UUID", errorInfo(t, err).GetReason())
+}
+
+// errorInfo returns ErrorInfo attached to the status error
+func errorInfo(t *testing.T, err error) *errdetails.ErrorInfo {
+	t.Helper()
+
+	for _, v := range status.Convert(err).Details() {
+		if info, ok := v.(*errdetails.ErrorInfo); ok {
+			return info
+		}
+	}
+
+	require.FailNow(t, "status has no error info")
+	return nil
+}
+
+func TestFollowRateLimitIsResourceExhausted(t *testing.T) {
+	ctx, st := suite.New(t)
+
+	limit := st.Cfg.Limits.FollowsPerMinute
+	if limit <= 0 {
+		t.Skip("follows rate limit is disabled")
+	}
+
+	rand := newRand()
+
+	src := randUUID(rand)
+	for range limit {
+		_, err := st.Client.Follow(ctx, &followv1.FollowRequest{Src: src, Target: randUUID(rand)})
+		require.NoError(t, err)
+	}
+
+	_, err := st.Client.Follow(ctx, &followv1.FollowRequest{Src: src, Target: randUUID(rand)})
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	require.Equal(t, "RATE_LIMIT_EXCEEDED", errorInfo(t, err).GetReason())
+
+	var retry *errdetails.RetryInfo
+	for _, v := range status.Convert(err).Details() {
+		if info, ok := v.(*errdetails.RetryInfo); ok {
+			retry = info
+		}
+	}
+	require.NotNil(t, retry)
+	require.Positive(t, retry.GetRetryDelay().AsDuration())
+	require.LessOrEqual(t, retry.GetRetryDelay().AsDuration(), time.Minute)
+}
@@ -0,0 +1,41 @@
+package tests
+
+import (
+	followv1 "github.com/IlianBuh/Follow_Service/protobuf/gen/go"
+	"github.com/IlianBuh/Follow_Service/tests/suite"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestFollowPath(t *testing.T) {
+	ctx, st := suite.New(t)
+
+	rand := newRand()
+
+	chain := randomInt32Slice(4, rand)
+	for i := 0; i+1 < len(chain); i++ {
+		_, err := st.Client.Follow(ctx, &followv1.FollowRequest{Src: chain[i], Target: chain[i+1]})
+		require.NoError(t, err)
+	}
+
+	resp, err := st.Client.FollowPath(
+		ctx,
+		&followv1.FollowPathRequest{
+			Src: chain[0],
+			Dst: chain[len(chain)-1],
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, chain, resp.GetPath())
+
+	resp, err = st.Client.FollowPath(
+		ctx,
+		&followv1.FollowPathRequest{
+			Src:      chain[0],
+			Dst:      chain[len(chain)-1],
+			MaxDepth: int32(len(chain) - 2),
+		},
+	)
+	require.NoError(t, err)
+	require.Empty(t, resp.GetPath())
+}
@@ -1,9 +1,11 @@
 package tests
 
 import (
-	followv1 "github.com/IlianBuh/Follow_Protobuf/gen/go"
+	followv1 "github.com/IlianBuh/Follow_Service/protobuf/gen/go"
 	"github.com/IlianBuh/Follow_Service/tests/suite"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"math/rand"
 	"testing"
 )
@@ -34,3 +36,29 @@ func TestFollowUnfollowHappy(t *testing.T) {
 	)
 	require.NoError(t, err)
 }
+
+func TestUnfollowTwice(t *testing.T) {
+	ctx, st := suite.New(t)
+
+	rand := newRand()
+
+	src, target := randUUID(rand), randUUID(rand)
+
+	_, err := st.Client.Follow(ctx, &followv1.FollowRequest{Src: src, Target: target})
+	require.NoError(t, err)
+
+	res, err := st.Client.Unfollow(ctx, &followv1.UnfollowRequest{Src: src, Target: target})
+	require.NoError(t, err)
+	require.True(t, res.GetUnfollowed())
+
+	res, err = st.Client.Unfollow(ctx, &followv1.UnfollowRequest{Src: src, Target: target})
+	require.NoError(t, err)
+	require.False(t, res.GetUnfollowed())
+
+	_, err = st.Client.Unfollow(ctx, &followv1.UnfollowRequest{Src: src, Target: target, Strict: true})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	cnt, err := st.Client.CountFollowees(ctx, &followv1.CountFolloweesRequest{Uuid: src})
+	require.NoError(t, err)
+	require.Zero(t, cnt.GetCount())
+}
@@ -0,0 +1,55 @@
+package tests
+
+import (
+	followv1 "github.com/IlianBuh/Follow_Service/protobuf/gen/go"
+	"github.com/IlianBuh/Follow_Service/tests/suite"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strconv"
+	"testing"
+)
+
+func TestFollowReplayedByIdempotencyKey(t *testing.T) {
+	ctx, st := suite.New(t)
+
+	rand := newRand()
+
+	src, target := randUUID(rand), randUUID(rand)
+	key := strconv.FormatInt(rand.Int63(), 36)
+
+	req := &followv1.FollowRequest{Src: src, Target: target, IdempotencyKey: key}
+	_, err := st.Client.Follow(ctx, req)
+	require.NoError(t, err)
+
+	_, err = st.Client.Follow(ctx, req)
+	require.NoError(t, err)
+
+	_, err = st.Client.Follow(ctx, &followv1.FollowRequest{Src: src, Target: target})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	_, err = st.Client.Unfollow(ctx, &followv1.UnfollowRequest{Src: src, Target: target, IdempotencyKey: key})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.Equal(t, "IDEMPOTENCY_KEY_REUSED", errorInfo(t, err).GetReason())
+}
+
+func TestUnfollowReplayedByIdempotencyKey(t *testing.T) {
+	ctx, st := suite.New(t)
+
+	rand := newRand()
+
+	src, target := randUUID(rand), randUUID(rand)
+	key := strconv.FormatInt(rand.Int63(), 36)
+
+	_, err := st.Client.Follow(ctx, &followv1.FollowRequest{Src: src, Target: target})
+	require.NoError(t, err)
+
+	req := &followv1.UnfollowRequest{Src: src, Target: target, Strict: true, IdempotencyKey: key}
+	res, err := st.Client.Unfollow(ctx, req)
+	require.NoError(t, err)
+	require.True(t, res.GetUnfollowed())
+
+	res, err = st.Client.Unfollow(ctx, req)
+	require.NoError(t, err)
+	require.True(t, res.GetUnfollowed())
+}
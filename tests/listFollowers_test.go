@@ -1,10 +1,11 @@
 package tests
 
 import (
-	followv1 "github.com/IlianBuh/Follow_Protobuf/gen/go"
+	followv1 "github.com/IlianBuh/Follow_Service/protobuf/gen/go"
 	"github.com/IlianBuh/Follow_Service/tests/suite"
 	"github.com/stretchr/testify/require"
 	"math/rand"
+	"sync"
 	"testing"
 	"time"
 )
@@ -12,7 +13,7 @@ import (
 func TestListFollowers(t *testing.T) {
 	ctx, st := suite.New(t)
 
-	rand := rand.New(rand.NewSource(time.Now().Unix()))
+	rand := newRand()
 
 	uuid := randUUID(rand)
 	followers := randomInt32Slice(10, rand)
@@ -37,6 +38,82 @@ func TestListFollowers(t *testing.T) {
 	require.Equal(t, followers, res.GetUuids())
 }
 
+func TestListFollowersPaged(t *testing.T) {
+	ctx, st := suite.New(t)
+
+	rand := newRand()
+
+	uuid := randUUID(rand)
+	followers := randomInt32Slice(10, rand)
+	for _, v := range followers {
+		_, err := st.Client.Follow(
+			ctx,
+			&followv1.FollowRequest{
+				Src:    v,
+				Target: uuid,
+			},
+		)
+		require.NoError(t, err)
+	}
+
+	const pageSize = 3
+	listed := make([]int32, 0, len(followers))
+	token := ""
+	for {
+		res, err := st.Client.ListFollowers(
+			ctx,
+			&followv1.ListFollowersRequest{
+				Uuid:      uuid,
+				PageSize:  pageSize,
+				PageToken: token,
+			},
+		)
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(res.GetUuids()), pageSize)
+
+		listed = append(listed, res.GetUuids()...)
+		token = res.GetNextPageToken()
+		if token == "" {
+			break
+		}
+	}
+	require.Equal(t, followers, listed)
+}
+
+func TestListFollowersNewestFirst(t *testing.T) {
+	ctx, st := suite.New(t)
+
+	rand := newRand()
+
+	uuid := randUUID(rand)
+	followers := randomInt32Slice(5, rand)
+	for _, v := range followers {
+		_, err := st.Client.Follow(
+			ctx,
+			&followv1.FollowRequest{
+				Src:    v,
+				Target: uuid,
+			},
+		)
+		require.NoError(t, err)
+	}
+
+	res, err := st.Client.ListFollowers(
+		ctx,
+		&followv1.ListFollowersRequest{
+			Uuid:  uuid,
+			Order: followv1.Order_ORDER_NEWEST_FIRST,
+		},
+	)
+	require.NoError(t, err)
+
+	require.Len(t, res.GetFollowings(), len(followers))
+	for i, v := range res.GetFollowings() {
+		require.Equal(t, followers[len(followers)-1-i], v.GetUuid())
+		require.NotNil(t, v.GetFollowedAt())
+	}
+}
+
 func randomInt32Slice(size int, rand *rand.Rand) []int32 {
 	res := make([]int32, size)
 
@@ -48,6 +125,36 @@ func randomInt32Slice(size int, rand *rand.Rand) []int32 {
 
 	return res
 }
+
+// sharedSource is the source of random values of all tests. Tests run in parallel
+// and must not draw the same uuids, so the source is seeded once and shared
+var sharedSource = &lockedSource{src: rand.NewSource(time.Now().UnixNano())}
+
+// lockedSource is the source of random values which is safe for concurrent use
+type lockedSource struct {
+	mu  sync.Mutex
+	src rand.Source
+}
+
+func (s *lockedSource) Int63() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.src.Int63()
+}
+
+func (s *lockedSource) Seed(seed int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.src.Seed(seed)
+}
+
+// newRand returns generator drawing values from the shared source
+func newRand() *rand.Rand {
+	return rand.New(sharedSource)
+}
+
 func randUUID(rand *rand.Rand) int32 {
-	return int32(rand.Uint32() & (1<<31 - 1))
+	return 1 + rand.Int31n(1<<31-1)
 }
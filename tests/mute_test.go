@@ -0,0 +1,45 @@
+package tests
+
+import (
+	followv1 "github.com/IlianBuh/Follow_Service/protobuf/gen/go"
+	"github.com/IlianBuh/Follow_Service/tests/suite"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestMuteExcludesFollowee(t *testing.T) {
+	ctx, st := suite.New(t)
+
+	rand := newRand()
+
+	src, target := randUUID(rand), randUUID(rand)
+
+	_, err := st.Client.Follow(ctx, &followv1.FollowRequest{Src: src, Target: target})
+	require.NoError(t, err)
+
+	_, err = st.Client.Mute(ctx, &followv1.MuteRequest{Src: src, Target: target})
+	require.NoError(t, err)
+
+	_, err = st.Client.Mute(ctx, &followv1.MuteRequest{Src: src, Target: target})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	followees, err := st.Client.ListFollowees(ctx, &followv1.ListFolloweesRequest{Uuid: src})
+	require.NoError(t, err)
+	require.Contains(t, followees.GetUuids(), target)
+
+	followees, err = st.Client.ListFollowees(ctx, &followv1.ListFolloweesRequest{Uuid: src, ExcludeMuted: true})
+	require.NoError(t, err)
+	require.NotContains(t, followees.GetUuids(), target)
+
+	_, err = st.Client.Unmute(ctx, &followv1.UnmuteRequest{Src: src, Target: target})
+	require.NoError(t, err)
+
+	followees, err = st.Client.ListFollowees(ctx, &followv1.ListFolloweesRequest{Uuid: src, ExcludeMuted: true})
+	require.NoError(t, err)
+	require.Contains(t, followees.GetUuids(), target)
+
+	_, err = st.Client.Unmute(ctx, &followv1.UnmuteRequest{Src: src, Target: target})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,54 @@
+package tests
+
+import (
+	followv1 "github.com/IlianBuh/Follow_Service/protobuf/gen/go"
+	"github.com/IlianBuh/Follow_Service/tests/suite"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestListCountMutuals(t *testing.T) {
+	ctx, st := suite.New(t)
+
+	rand := newRand()
+
+	uuid := randUUID(rand)
+	followees := randomInt32Slice(5, rand)
+	for _, v := range followees {
+		_, err := st.Client.Follow(ctx, &followv1.FollowRequest{Src: uuid, Target: v})
+		require.NoError(t, err)
+	}
+
+	mutuals := followees[:3]
+	for _, v := range mutuals {
+		_, err := st.Client.Follow(ctx, &followv1.FollowRequest{Src: v, Target: uuid})
+		require.NoError(t, err)
+	}
+
+	var (
+		got   []int32
+		token string
+	)
+	for {
+		page, err := st.Client.ListMutuals(
+			ctx,
+			&followv1.ListMutualsRequest{
+				Uuid:      uuid,
+				PageSize:  2,
+				PageToken: token,
+			},
+		)
+		require.NoError(t, err)
+
+		got = append(got, page.GetUuids()...)
+		token = page.GetNextPageToken()
+		if token == "" {
+			break
+		}
+	}
+	require.ElementsMatch(t, mutuals, got)
+
+	cnt, err := st.Client.CountMutuals(ctx, &followv1.CountMutualsRequest{Uuid: uuid})
+	require.NoError(t, err)
+	require.Equal(t, int64(len(mutuals)), cnt.GetCount())
+}
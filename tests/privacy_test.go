@@ -0,0 +1,49 @@
+package tests
+
+import (
+	followv1 "github.com/IlianBuh/Follow_Service/protobuf/gen/go"
+	"github.com/IlianBuh/Follow_Service/tests/suite"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestFollowPrivateAccount(t *testing.T) {
+	ctx, st := suite.New(t)
+
+	rand := newRand()
+
+	owner, requester := randUUID(rand), randUUID(rand)
+
+	_, err := st.Client.SetPrivacy(ctx, &followv1.SetPrivacyRequest{Uuid: owner, Private: true})
+	require.NoError(t, err)
+
+	privacy, err := st.Client.GetPrivacy(ctx, &followv1.GetPrivacyRequest{Uuid: owner})
+	require.NoError(t, err)
+	require.True(t, privacy.GetPrivate())
+
+	res, err := st.Client.Follow(ctx, &followv1.FollowRequest{Src: requester, Target: owner})
+	require.NoError(t, err)
+	require.Equal(t, followv1.FollowState_FOLLOW_STATE_REQUESTED, res.GetState())
+
+	followers, err := st.Client.ListFollowers(ctx, &followv1.ListFollowersRequest{Uuid: owner})
+	require.NoError(t, err)
+	require.Empty(t, followers.GetUuids())
+
+	requests, err := st.Client.ListFollowRequests(ctx, &followv1.ListFollowRequestsRequest{Uuid: owner})
+	require.NoError(t, err)
+	require.Len(t, requests.GetRequests(), 1)
+	require.Equal(t, requester, requests.GetRequests()[0].GetUuid())
+
+	_, err = st.Client.ApproveFollowRequest(
+		ctx,
+		&followv1.ApproveFollowRequestRequest{
+			Uuid:      owner,
+			Requester: requester,
+		},
+	)
+	require.NoError(t, err)
+
+	followers, err = st.Client.ListFollowers(ctx, &followv1.ListFollowersRequest{Uuid: owner})
+	require.NoError(t, err)
+	require.Equal(t, []int32{requester}, followers.GetUuids())
+}
@@ -0,0 +1,58 @@
+package tests
+
+import (
+	followv1 "github.com/IlianBuh/Follow_Service/protobuf/gen/go"
+	"github.com/IlianBuh/Follow_Service/tests/suite"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestGetRelationships(t *testing.T) {
+	ctx, st := suite.New(t)
+
+	rand := newRand()
+
+	viewer := randUUID(rand)
+	targets := randomInt32Slice(3, rand)
+	follows := [][2]int32{
+		{viewer, targets[0]},
+		{targets[0], viewer},
+		{viewer, targets[1]},
+	}
+	for _, v := range follows {
+		_, err := st.Client.Follow(
+			ctx,
+			&followv1.FollowRequest{
+				Src:    v[0],
+				Target: v[1],
+			},
+		)
+		require.NoError(t, err)
+	}
+
+	res, err := st.Client.GetRelationships(
+		ctx,
+		&followv1.GetRelationshipsRequest{
+			Viewer:  viewer,
+			Targets: targets,
+		},
+	)
+	require.NoError(t, err)
+
+	rels := res.GetRelationships()
+	require.Len(t, rels, len(targets))
+
+	require.Equal(t, targets[0], rels[0].GetUuid())
+	require.True(t, rels[0].GetFollowing())
+	require.True(t, rels[0].GetFollowedBy())
+	require.True(t, rels[0].GetMutual())
+
+	require.Equal(t, targets[1], rels[1].GetUuid())
+	require.True(t, rels[1].GetFollowing())
+	require.False(t, rels[1].GetFollowedBy())
+	require.False(t, rels[1].GetMutual())
+
+	require.Equal(t, targets[2], rels[2].GetUuid())
+	require.False(t, rels[2].GetFollowing())
+	require.False(t, rels[2].GetFollowedBy())
+}
@@ -0,0 +1,52 @@
+package tests
+
+import (
+	"errors"
+	followv1 "github.com/IlianBuh/Follow_Service/protobuf/gen/go"
+	"github.com/IlianBuh/Follow_Service/tests/suite"
+	"github.com/stretchr/testify/require"
+	"io"
+	"testing"
+)
+
+func TestStreamFollowers(t *testing.T) {
+	ctx, st := suite.New(t)
+
+	rand := newRand()
+
+	uuid := randUUID(rand)
+	followers := randomInt32Slice(10, rand)
+	for _, v := range followers {
+		_, err := st.Client.Follow(
+			ctx,
+			&followv1.FollowRequest{
+				Src:    v,
+				Target: uuid,
+			},
+		)
+		require.NoError(t, err)
+	}
+
+	const chunkSize = 4
+	stream, err := st.Client.StreamFollowers(
+		ctx,
+		&followv1.StreamFollowersRequest{
+			Uuid:      uuid,
+			ChunkSize: chunkSize,
+		},
+	)
+	require.NoError(t, err)
+
+	streamed := make([]int32, 0, len(followers))
+	for {
+		res, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(res.GetUuids()), chunkSize)
+
+		streamed = append(streamed, res.GetUuids()...)
+	}
+	require.Equal(t, followers, streamed)
+}
@@ -0,0 +1,45 @@
+package tests
+
+import (
+	followv1 "github.com/IlianBuh/Follow_Service/protobuf/gen/go"
+	"github.com/IlianBuh/Follow_Service/tests/suite"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestSuggestFollows(t *testing.T) {
+	ctx, st := suite.New(t)
+
+	rand := newRand()
+
+	uuid := randUUID(rand)
+	followees := randomInt32Slice(3, rand)
+	popular, other := randUUID(rand), randUUID(rand)
+
+	for _, v := range followees {
+		_, err := st.Client.Follow(ctx, &followv1.FollowRequest{Src: uuid, Target: v})
+		require.NoError(t, err)
+
+		_, err = st.Client.Follow(ctx, &followv1.FollowRequest{Src: v, Target: popular})
+		require.NoError(t, err)
+	}
+	_, err := st.Client.Follow(ctx, &followv1.FollowRequest{Src: followees[0], Target: other})
+	require.NoError(t, err)
+	_, err = st.Client.Follow(ctx, &followv1.FollowRequest{Src: followees[1], Target: uuid})
+	require.NoError(t, err)
+
+	resp, err := st.Client.SuggestFollows(ctx, &followv1.SuggestFollowsRequest{Uuid: uuid})
+	require.NoError(t, err)
+
+	suggestions := resp.GetSuggestions()
+	require.NotEmpty(t, suggestions)
+	require.Equal(t, popular, suggestions[0].GetUuid())
+	require.Equal(t, int32(len(followees)), suggestions[0].GetPaths())
+
+	uuids := make([]int32, len(suggestions))
+	for i, v := range suggestions {
+		uuids[i] = v.GetUuid()
+	}
+	require.Contains(t, uuids, other)
+	require.NotContains(t, uuids, uuid)
+}
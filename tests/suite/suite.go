@@ -2,8 +2,8 @@ package suite
 
 import (
 	"context"
-	followv1 "github.com/IlianBuh/Follow_Protobuf/gen/go"
 	"github.com/IlianBuh/Follow_Service/internal/config"
+	followv1 "github.com/IlianBuh/Follow_Service/protobuf/gen/go"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"net"
@@ -13,8 +13,9 @@ import (
 
 type Suite struct {
 	*testing.T
-	Client followv1.FollowClient
-	Cfg    *config.Config
+	Client      followv1.FollowClient
+	AdminClient followv1.FollowAdminClient
+	Cfg         *config.Config
 }
 
 func New(t *testing.T) (context.Context, *Suite) {
@@ -38,10 +39,21 @@ func New(t *testing.T) (context.Context, *Suite) {
 		t.Fatalf("failed to connect to server: %v", err)
 	}
 
+	adminAddr := net.JoinHostPort("localhost", strconv.Itoa(cfg.GRPC.AdminPort))
+	adminCC, err := grpc.NewClient(
+		adminAddr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("failed to connect to admin server: %v", err)
+	}
+
 	client := followv1.NewFollowClient(cc)
+	adminClient := followv1.NewFollowAdminClient(adminCC)
 	return ctx, &Suite{
-		T:      t,
-		Cfg:    cfg,
-		Client: client,
+		T:           t,
+		Cfg:         cfg,
+		Client:      client,
+		AdminClient: adminClient,
 	}
 }
@@ -0,0 +1,61 @@
+package tests
+
+import (
+	followv1 "github.com/IlianBuh/Follow_Service/protobuf/gen/go"
+	"github.com/IlianBuh/Follow_Service/tests/suite"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+	"time"
+)
+
+func TestWatchFollows(t *testing.T) {
+	ctx, st := suite.New(t)
+
+	rand := newRand()
+
+	uuid := randUUID(rand)
+	followers := randomInt32Slice(2, rand)
+
+	stream, err := st.Client.WatchFollows(ctx, &followv1.WatchFollowsRequest{Uuid: uuid})
+	require.NoError(t, err)
+
+	// the server reads the start position asynchronously, the delay lets it do that before following
+	time.Sleep(time.Second)
+	for _, v := range followers {
+		_, err = st.Client.Follow(ctx, &followv1.FollowRequest{Src: v, Target: uuid})
+		require.NoError(t, err)
+	}
+
+	first, err := stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, followv1.FollowEventType_FOLLOW_EVENT_TYPE_FOLLOWED, first.GetType())
+	require.Equal(t, followers[0], first.GetFollower())
+	require.Equal(t, uuid, first.GetFollowee())
+	require.NotEmpty(t, first.GetResumeToken())
+
+	second, err := stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, followers[1], second.GetFollower())
+
+	resumed, err := st.Client.WatchFollows(ctx, &followv1.WatchFollowsRequest{
+		Uuid:        uuid,
+		ResumeToken: first.GetResumeToken(),
+	})
+	require.NoError(t, err)
+
+	event, err := resumed.Recv()
+	require.NoError(t, err)
+	require.Equal(t, followers[1], event.GetFollower())
+}
+
+func TestWatchFollows_InvalidResumeToken(t *testing.T) {
+	ctx, st := suite.New(t)
+
+	stream, err := st.Client.WatchFollows(ctx, &followv1.WatchFollowsRequest{Uuid: 1, ResumeToken: "!"})
+	require.NoError(t, err)
+
+	_, err = stream.Recv()
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}